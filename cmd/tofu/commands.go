@@ -103,6 +103,7 @@ func initCommands(
 		PluginCacheDir:      config.PluginCacheDir,
 
 		PluginCacheMayBreakDependencyLockFile: config.PluginCacheMayBreakDependencyLockFile,
+		ProviderSchemaCache:                   config.ProviderSchemaCache,
 
 		ShutdownCh:    makeShutdownCh(),
 		CallerContext: ctx,
@@ -366,6 +367,12 @@ func initCommands(
 			return &command.StateCommand{}, nil
 		},
 
+		"state diff": func() (cli.Command, error) {
+			return &command.StateDiffCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"state list": func() (cli.Command, error) {
 			return &command.StateListCommand{
 				Meta: meta,
@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/apparentlymart/go-shquot/shquot"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/terraform-svchost/disco"
 	"github.com/mattn/go-shellwords"
@@ -164,6 +165,16 @@ func realMain() int {
 		}
 	}
 
+	// Trust any additional CA certificates the user asked for before we
+	// create any of the HTTP clients that contact registries and download
+	// modules and providers.
+	if caCertFile := os.Getenv(httpclient.CACertFileEnvVar); caCertFile != "" {
+		if err := httpclient.LoadCACertFile(caCertFile); err != nil {
+			Ui.Error(fmt.Sprintf("Invalid %s environment variable: %s", httpclient.CACertFileEnvVar, err))
+			return 1
+		}
+	}
+
 	// Get any configured credentials from the config and initialize
 	// a service discovery object. The slightly awkward predeclaration of
 	// disco is required to allow us to pass untyped nil as the creds source
@@ -183,7 +194,7 @@ func realMain() int {
 		// object checks that and just acts as though no credentials are present.
 		services = disco.NewWithCredentialsSource(nil)
 	}
-	services.SetUserAgent(httpclient.OpenTofuUserAgent(version.String()))
+	configureServiceTransport(services)
 
 	providerSrc, diags := providerSource(config.ProviderInstallation, services)
 	if len(diags) > 0 {
@@ -521,3 +532,15 @@ func mkConfigDir(configDir string) error {
 
 	return err
 }
+
+// configureServiceTransport gives the service discovery client a transport
+// that trusts any CA certificates loaded via TF_CA_CERT_FILE, wrapped so that
+// requests carry the OpenTofu User-Agent.
+//
+// The transport disco installs by default is already wrapped for its own
+// User-Agent and so cannot be configured after the fact; we replace it with
+// a fresh pooled transport instead.
+func configureServiceTransport(services *disco.Disco) {
+	services.Transport = httpclient.ConfigureTransport(cleanhttp.DefaultPooledTransport())
+	services.SetUserAgent(httpclient.OpenTofuUserAgent(version.String()))
+}
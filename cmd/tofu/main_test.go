@@ -6,14 +6,22 @@
 package main
 
 import (
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"testing"
 
+	"github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/disco"
 	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/httpclient"
 )
 
 func TestMain_cliArgsFromEnv(t *testing.T) {
@@ -368,3 +376,44 @@ func TestMkConfigDir_noparent(t *testing.T) {
 		t.Fatalf("Expected error: %s, but got: %v", expectedError, err)
 	}
 }
+
+func TestConfigureServiceTransport_customCA(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/.well-known/terraform.json" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{"modules.v1":"/modules/"}`))
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	host, err := svchost.ForComparison(u.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := httpclient.LoadCACertFile(caFile); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	services := disco.New()
+	configureServiceTransport(services)
+
+	got, err := services.Discover(host)
+	if err != nil {
+		t.Fatalf("discovery failed: %s", err)
+	}
+	if _, err := got.ServiceURL("modules.v1"); err != nil {
+		t.Fatalf("modules.v1 not discovered: %s", err)
+	}
+}
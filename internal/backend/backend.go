@@ -279,10 +279,11 @@ type Operation struct {
 
 	// The options below are more self-explanatory and affect the runtime
 	// behavior of the operation.
-	PlanMode     plans.Mode
-	AutoApprove  bool
-	Targets      []addrs.Targetable
-	ForceReplace []addrs.AbsResourceInstance
+	PlanMode            plans.Mode
+	AutoApprove         bool
+	Targets             []addrs.Targetable
+	ForceReplace        []addrs.AbsResourceInstance
+	ForceReplaceModules []addrs.ModuleInstance
 	// Injected by the command creating the operation (plan/apply/refresh/etc...)
 	Variables map[string]UnparsedVariableValue
 	RootCall  configs.StaticModuleCall
@@ -389,6 +390,11 @@ type RunningOperation struct {
 	// the exit status because the plan value is not available at that point.
 	PlanEmpty bool
 
+	// PlanChanges is populated after a Plan operation completes with the
+	// changes the plan proposes, so that the CLI can describe them when it
+	// wasn't expecting any. Backends that run plans remotely leave it nil.
+	PlanChanges *plans.Changes
+
 	// State is the final state after the operation completed. Persisting
 	// this state is managed by the backend. This should only be read
 	// after the operation completes to avoid read/write races.
@@ -201,12 +201,13 @@ func (b *Local) localRunDirect(op *backend.Operation, run *backend.LocalRun, cor
 	}
 
 	planOpts := &tofu.PlanOpts{
-		Mode:               op.PlanMode,
-		Targets:            op.Targets,
-		ForceReplace:       op.ForceReplace,
-		SetVariables:       variables,
-		SkipRefresh:        op.Type != backend.OperationTypeRefresh && !op.PlanRefresh,
-		GenerateConfigPath: op.GenerateConfigOut,
+		Mode:                op.PlanMode,
+		Targets:             op.Targets,
+		ForceReplace:        op.ForceReplace,
+		ForceReplaceModules: op.ForceReplaceModules,
+		SetVariables:        variables,
+		SkipRefresh:         op.Type != backend.OperationTypeRefresh && !op.PlanRefresh,
+		GenerateConfigPath:  op.GenerateConfigOut,
 	}
 	run.PlanOpts = planOpts
 
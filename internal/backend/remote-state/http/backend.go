@@ -121,6 +121,19 @@ func New(enc encryption.StateEncryption) backend.Backend {
 				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_CLIENT_PRIVATE_KEY_PEM", ""),
 				Description: "A PEM-encoded private key, required if client_certificate_pem is specified.",
 			},
+			"hmac_secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_HMAC_SECRET", ""),
+				Description: "A secret used to sign the body of lock, unlock and state update requests with HMAC-SHA256.",
+			},
+			"signature_header": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_HTTP_SIGNATURE_HEADER", "X-Signature"),
+				Description: "The header to send the request signature in when hmac_secret is set.",
+			},
 			"headers": &schema.Schema{
 				Type:     schema.TypeMap,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -275,10 +288,28 @@ func (b *Backend) configure(ctx context.Context) error {
 		}
 	}
 
+	hmacSecret := data.Get("hmac_secret").(string)
+	signatureHeader := data.Get("signature_header").(string)
+	if hmacSecret != "" {
+		if strings.TrimSpace(signatureHeader) == "" {
+			return fmt.Errorf("signature_header must not be empty when hmac_secret is set")
+		}
+		switch strings.ToLower(signatureHeader) {
+		case "authorization", "content-type", "content-md5":
+			return fmt.Errorf("signature_header \"%s\" is reserved", signatureHeader)
+		}
+		for k := range headers {
+			if strings.EqualFold(k, signatureHeader) {
+				return fmt.Errorf("headers \"%s\" cannot be set when it is used as the signature_header", k)
+			}
+		}
+	}
+
 	rClient := retryablehttp.NewClient()
 	rClient.RetryMax = data.Get("retry_max").(int)
 	rClient.RetryWaitMin = time.Duration(data.Get("retry_wait_min").(int)) * time.Second
 	rClient.RetryWaitMax = time.Duration(data.Get("retry_wait_max").(int)) * time.Second
+	rClient.CheckRetry = retryPolicy
 	rClient.Logger = log.New(logging.LogOutput(), "", log.Flags())
 	if err = b.configureTLS(rClient, data); err != nil {
 		return err
@@ -297,6 +328,9 @@ func (b *Backend) configure(ctx context.Context) error {
 		Username: username,
 		Password: password,
 
+		HMACSecret:      []byte(hmacSecret),
+		SignatureHeader: signatureHeader,
+
 		// accessible only for testing use
 		Client: rClient,
 	}
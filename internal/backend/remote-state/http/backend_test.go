@@ -51,6 +51,9 @@ func TestHTTPClientFactory(t *testing.T) {
 	if client.Headers != nil {
 		t.Fatal("Unexpected headers")
 	}
+	if len(client.HMACSecret) != 0 || client.SignatureHeader != "X-Signature" {
+		t.Fatalf("Unexpected hmac_secret or signature_header \"%s\"", client.SignatureHeader)
+	}
 
 	// custom
 	conf = map[string]cty.Value{
@@ -68,6 +71,8 @@ func TestHTTPClientFactory(t *testing.T) {
 		"headers": cty.MapVal(map[string]cty.Value{
 			"user-defined": cty.StringVal("test"),
 		}),
+		"hmac_secret":      cty.StringVal("secret"),
+		"signature_header": cty.StringVal("X-Proxy-Signature"),
 	}
 
 	b = backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), configs.SynthBody("synth", conf)).(*Backend)
@@ -104,6 +109,9 @@ func TestHTTPClientFactory(t *testing.T) {
 	if len(client.Headers) != 1 || client.Headers["user-defined"] != "test" {
 		t.Fatalf("Expected headers \"user-defined\" to be \"test\", got \"%s\"", client.Headers)
 	}
+	if string(client.HMACSecret) != "secret" || client.SignatureHeader != "X-Proxy-Signature" {
+		t.Fatalf("Unexpected hmac_secret \"%s\" or signature_header \"%s\"", client.HMACSecret, client.SignatureHeader)
+	}
 
 	// authorization header
 	conf = map[string]cty.Value{
@@ -7,11 +7,16 @@ package http
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
 	"net/url"
 
@@ -38,10 +43,35 @@ type httpClient struct {
 	Username string
 	Password string
 
+	// Request signing
+	HMACSecret      []byte
+	SignatureHeader string
+
 	lockID       string
 	jsonLockInfo []byte
 }
 
+// retryPolicy decides whether a request to the HTTP backend should be retried.
+// Like retryablehttp.DefaultRetryPolicy it retries after connection errors and
+// most 5xx responses, but it never retries a 4xx response: those are returned
+// to the caller immediately, so that for example 423 Locked is reported as a
+// lock error rather than waited out.
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if err == nil && resp.StatusCode < 500 {
+		return false, nil
+	}
+
+	retry, retryErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if retry {
+		if err != nil {
+			log.Printf("[TRACE] HTTP remote state: request failed, will retry: %s", err)
+		} else {
+			log.Printf("[TRACE] HTTP remote state: %s %s returned %d, will retry", resp.Request.Method, resp.Request.URL.Redacted(), resp.StatusCode)
+		}
+	}
+	return retry, retryErr
+}
+
 func (c *httpClient) httpRequest(method string, url *url.URL, data []byte, what string) (*http.Response, error) {
 	var body interface{}
 	if len(data) > 0 {
@@ -71,6 +101,10 @@ func (c *httpClient) httpRequest(method string, url *url.URL, data []byte, what
 		hash := md5.Sum(data)
 		b64 := base64.StdEncoding.EncodeToString(hash[:])
 		req.Header.Set("Content-MD5", b64)
+
+		if len(c.HMACSecret) > 0 {
+			req.Header.Set(c.SignatureHeader, signPayload(c.HMACSecret, data))
+		}
 	}
 
 	// Make the request
@@ -82,6 +116,14 @@ func (c *httpClient) httpRequest(method string, url *url.URL, data []byte, what
 	return resp, nil
 }
 
+// signPayload returns the hex-encoded HMAC-SHA256 of the given request body,
+// keyed with the given secret.
+func signPayload(secret []byte, data []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func (c *httpClient) Lock(info *statemgr.LockInfo) (string, error) {
 	if c.LockURL == nil {
 		return "", nil
@@ -189,19 +231,23 @@ func (c *httpClient) Get() (*remote.Payload, error) {
 		return nil, nil
 	}
 
-	// Check for the MD5
-	if raw := resp.Header.Get("Content-MD5"); raw != "" {
-		md5, err := base64.StdEncoding.DecodeString(raw)
+	// Generate the MD5, and check it against the one in the response if any.
+	// The response is the one from the final attempt if the request was
+	// retried, so this also catches a body that was corrupted in transit.
+	// If the transport decompressed the body, the header describes the
+	// compressed body instead and so can't be checked.
+	hash := md5.Sum(payload.Data)
+	payload.MD5 = hash[:]
+	if raw := resp.Header.Get("Content-MD5"); raw != "" && !resp.Uncompressed {
+		want, err := base64.StdEncoding.DecodeString(raw)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"Failed to decode Content-MD5 '%s': %w", raw, err)
 		}
-
-		payload.MD5 = md5
-	} else {
-		// Generate the MD5
-		hash := md5.Sum(payload.Data)
-		payload.MD5 = hash[:]
+		if !bytes.Equal(want, payload.MD5) {
+			return nil, fmt.Errorf(
+				"HTTP remote state data does not match Content-MD5 '%s'", raw)
+		}
 	}
 
 	return payload, nil
@@ -7,16 +7,25 @@ package http
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/opentofu/opentofu/internal/states/remote"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
 )
 
 func TestHTTPClient_impl(t *testing.T) {
@@ -127,6 +136,172 @@ func TestHTTPClient(t *testing.T) {
 	remote.TestClient(t, client)
 }
 
+func TestHTTPClient_retry(t *testing.T) {
+	state := []byte(`{"version":4}`)
+	hash := md5.Sum(state)
+	goodMD5 := base64.StdEncoding.EncodeToString(hash[:])
+
+	tests := map[string]struct {
+		method    string
+		statuses  []int
+		md5       string
+		wantCalls int
+		wantErr   string
+	}{
+		"success after server errors": {
+			method:    "GET",
+			statuses:  []int{503, 502, 200},
+			md5:       goodMD5,
+			wantCalls: 3,
+		},
+		"gives up after retry_max": {
+			method:    "GET",
+			statuses:  []int{503, 503, 503, 503},
+			wantCalls: 3,
+			wantErr:   "giving up after 3 attempt(s)",
+		},
+		"no retry on client errors": {
+			method:    "GET",
+			statuses:  []int{429, 200},
+			wantCalls: 1,
+			wantErr:   "Unexpected HTTP response code 429",
+		},
+		"no retry when locked": {
+			method:    "LOCK",
+			statuses:  []int{423, 200},
+			wantCalls: 1,
+			wantErr:   "HTTP remote state already locked",
+		},
+		"lock after server error": {
+			method:    "LOCK",
+			statuses:  []int{500, 200},
+			wantCalls: 2,
+		},
+		"checksum verified on final response": {
+			method:    "GET",
+			statuses:  []int{503, 200},
+			md5:       base64.StdEncoding.EncodeToString([]byte("not the right checksum")),
+			wantCalls: 2,
+			wantErr:   "does not match Content-MD5",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := test.statuses[calls]
+				calls++
+				if status == 200 && r.Method == "GET" {
+					if test.md5 != "" {
+						w.Header().Set("Content-MD5", test.md5)
+					}
+					w.Write(state)
+					return
+				}
+				w.WriteHeader(status)
+				if status == 423 {
+					w.Write([]byte(`{"ID":"other"}`))
+				}
+			}))
+			defer ts.Close()
+
+			url, err := url.Parse(ts.URL)
+			if err != nil {
+				t.Fatalf("Parse: %s", err)
+			}
+			c := retryablehttp.NewClient()
+			c.RetryMax = 2
+			c.RetryWaitMin = time.Millisecond
+			c.RetryWaitMax = time.Millisecond
+			c.CheckRetry = retryPolicy
+			client := &httpClient{
+				URL:        url,
+				LockURL:    url,
+				LockMethod: "LOCK",
+				Client:     c,
+			}
+
+			switch test.method {
+			case "GET":
+				var payload *remote.Payload
+				payload, err = client.Get()
+				if err == nil && !bytes.Equal(payload.Data, state) {
+					t.Errorf("wrong state data %q", payload.Data)
+				}
+			case "LOCK":
+				_, err = client.Lock(statemgr.NewLockInfo())
+				if test.wantErr != "" {
+					var lockErr *statemgr.LockError
+					if !errors.As(err, &lockErr) {
+						t.Errorf("expected a lock error, got %#v", err)
+					}
+				}
+			}
+
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("wrong error\ngot:  %v\nwant: %s", err, test.wantErr)
+			}
+			if calls != test.wantCalls {
+				t.Errorf("wrong number of requests: got %d, want %d", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_signed(t *testing.T) {
+	secret := []byte("hunter2")
+	handler := &testSigningHTTPHandler{
+		secret:  secret,
+		header:  "X-Proxy-Signature",
+		handler: new(testHTTPHandler),
+	}
+	ts := httptest.NewServer(http.HandlerFunc(handler.Handle))
+	defer ts.Close()
+
+	url, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+	newClient := func(secret []byte) *httpClient {
+		c := retryablehttp.NewClient()
+		c.CheckRetry = retryPolicy
+		return &httpClient{
+			URL:             url,
+			UpdateMethod:    "PUT",
+			LockURL:         url,
+			LockMethod:      "LOCK",
+			UnlockURL:       url,
+			UnlockMethod:    "UNLOCK",
+			HMACSecret:      secret,
+			SignatureHeader: "X-Proxy-Signature",
+			Client:          c,
+		}
+	}
+
+	// Correctly-signed state updates, locks and unlocks are all accepted.
+	remote.TestClient(t, newClient(secret))
+	remote.TestRemoteLocks(t, newClient(secret), newClient(secret))
+
+	for name, client := range map[string]*httpClient{
+		"wrong secret": newClient([]byte("not the secret")),
+		"no secret":    newClient(nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := client.Put([]byte(`{"version":4}`)); err == nil {
+				t.Error("mis-signed state update succeeded; want error")
+			}
+			if _, err := client.Lock(statemgr.NewLockInfo()); err == nil {
+				t.Error("mis-signed lock succeeded; want error")
+			}
+		})
+	}
+}
+
 type testHTTPHandler struct {
 	Data   []byte
 	Locked bool
@@ -192,6 +367,33 @@ func (h *testHTTPHandler) HandleWebDAV(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// testSigningHTTPHandler rejects any request with a body that does not carry
+// a valid HMAC-SHA256 signature of it, as an authenticating proxy would.
+type testSigningHTTPHandler struct {
+	secret  []byte
+	header  string
+	handler *testHTTPHandler
+}
+
+func (h *testSigningHTTPHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(500)
+		return
+	}
+	if len(body) > 0 {
+		got, err := hex.DecodeString(r.Header.Get(h.header))
+		mac := hmac.New(sha256.New, h.secret)
+		mac.Write(body)
+		if err != nil || !hmac.Equal(got, mac.Sum(nil)) {
+			w.WriteHeader(403)
+			return
+		}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	h.handler.Handle(w, r)
+}
+
 type testBrokenHTTPHandler struct {
 	lastRequestWasBroken bool
 	handler              *testHTTPHandler
@@ -21,6 +21,7 @@ import (
 const (
 	statesTableName = "states"
 	statesIndexName = "states_by_name"
+	locksTableName  = "locks"
 )
 
 func defaultBoolFunc(k string, dv bool) schema.SchemaDefaultFunc {
@@ -139,6 +140,15 @@ func (b *Backend) configure(ctx context.Context) error {
 		if _, err := db.Exec(fmt.Sprintf(query, b.schemaName, statesTableName)); err != nil {
 			return err
 		}
+
+		query = `CREATE TABLE IF NOT EXISTS %s.%s (
+			name text PRIMARY KEY,
+			lock_id text NOT NULL,
+			info text NOT NULL
+			)`
+		if _, err := db.Exec(fmt.Sprintf(query, b.schemaName, locksTableName)); err != nil {
+			return err
+		}
 	}
 
 	if !data.Get("skip_index_creation").(bool) {
@@ -6,16 +6,25 @@
 package pg
 
 import (
+	"context"
 	"crypto/md5"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	uuid "github.com/hashicorp/go-uuid"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/opentofu/opentofu/internal/states/remote"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
 )
 
+// lockKeepaliveInterval is how often the connection holding a lock is
+// pinged while the lock is held.
+var lockKeepaliveInterval = 30 * time.Second
+
 // RemoteClient is a remote client that stores data in a Postgres database
 type RemoteClient struct {
 	Client     *sql.DB
@@ -23,6 +32,20 @@ type RemoteClient struct {
 	SchemaName string
 
 	info *statemgr.LockInfo
+
+	// lockConn is the dedicated connection holding the advisory lock while
+	// this client holds the lock. Advisory locks belong to the database
+	// session that took them, so they must be taken and released on the
+	// same connection, rather than on whichever connection the pool hands
+	// out.
+	lockConn      *sql.Conn
+	stopKeepalive func()
+
+	// lockLost is set by the keepalive if the connection holding the lock
+	// fails, at which point the database has released the lock. It's
+	// reported when unlocking, since the state may have been changed by
+	// others while the lock wasn't held.
+	lockLost error
 }
 
 func (c *RemoteClient) Get() (*remote.Payload, error) {
@@ -77,55 +100,155 @@ func (c *RemoteClient) Lock(info *statemgr.LockInfo) (string, error) {
 		info.ID = lockID
 	}
 
-	// Local helper function so we can call it multiple places
-	//
-	lockUnlock := func(pgLockId string) error {
-		query := `SELECT pg_advisory_unlock(%s)`
-		row := c.Client.QueryRow(fmt.Sprintf(query, pgLockId))
-		var didUnlock []byte
-		err := row.Scan(&didUnlock)
-		if err != nil {
-			return &statemgr.LockError{Info: info, Err: err}
+	if c.lockConn != nil {
+		return "", &statemgr.LockError{Info: c.info, Err: fmt.Errorf("Workspace is already locked by this client: %s", c.Name)}
+	}
+
+	ctx := context.Background()
+	conn, err := c.Client.Conn(ctx)
+	if err != nil {
+		return "", &statemgr.LockError{Info: info, Err: err}
+	}
+
+	pgLockId, err := c.acquireAdvisoryLock(ctx, conn, info)
+	if err != nil {
+		conn.Close()
+		return "", err
+	}
+	info.Path = pgLockId
+
+	if err := c.writeLockInfo(ctx, conn, info); err != nil {
+		advisoryUnlock(ctx, conn, pgLockId)
+		conn.Close()
+		return "", &statemgr.LockError{Info: info, Err: err}
+	}
+
+	c.info = info
+	c.lockConn = conn
+	c.startKeepalive(conn)
+
+	return info.ID, nil
+}
+
+// acquireAdvisoryLock takes the advisory lock for the workspace on the given
+// connection, returning the key of the lock that was taken.
+func (c *RemoteClient) acquireAdvisoryLock(ctx context.Context, conn *sql.Conn, info *statemgr.LockInfo) (string, error) {
+	// lockErr returns a LockError describing the existing lock, if there's
+	// a record of it.
+	lockErr := func(err error) error {
+		if existing, _ := c.storedLockInfo(ctx, c.Client); existing != nil {
+			return &statemgr.LockError{Info: existing, Err: err}
 		}
-		return nil
+		return &statemgr.LockError{Info: info, Err: err}
 	}
 
 	// Try to acquire locks for the existing row `id` and the creation lock `-1`.
 	query := `SELECT %s.id, pg_try_advisory_lock(%s.id), pg_try_advisory_lock(-1) FROM %s.%s WHERE %s.name = $1`
-	row := c.Client.QueryRow(fmt.Sprintf(query, statesTableName, statesTableName, c.SchemaName, statesTableName, statesTableName), c.Name)
+	row := conn.QueryRowContext(ctx, fmt.Sprintf(query, statesTableName, statesTableName, c.SchemaName, statesTableName, statesTableName), c.Name)
 	var pgLockId, didLock, didLockForCreate []byte
-	err = row.Scan(&pgLockId, &didLock, &didLockForCreate)
+	err := row.Scan(&pgLockId, &didLock, &didLockForCreate)
 	switch {
 	case err == sql.ErrNoRows:
 		// No rows means we're creating the workspace. Take the creation lock.
-		innerRow := c.Client.QueryRow(`SELECT pg_try_advisory_lock(-1)`)
+		innerRow := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(-1)`)
 		var innerDidLock []byte
 		err := innerRow.Scan(&innerDidLock)
 		if err != nil {
 			return "", &statemgr.LockError{Info: info, Err: err}
 		}
 		if string(innerDidLock) == "false" {
-			return "", &statemgr.LockError{Info: info, Err: fmt.Errorf("Already locked for workspace creation: %s", c.Name)}
+			return "", lockErr(fmt.Errorf("Already locked for workspace creation: %s", c.Name))
 		}
-		info.Path = "-1"
+		return "-1", nil
 	case err != nil:
 		return "", &statemgr.LockError{Info: info, Err: err}
 	case string(didLock) == "false":
 		// Existing workspace is already locked. Release the attempted creation lock.
-		lockUnlock("-1")
-		return "", &statemgr.LockError{Info: info, Err: fmt.Errorf("Workspace is already locked: %s", c.Name)}
+		advisoryUnlock(ctx, conn, "-1")
+		return "", lockErr(fmt.Errorf("Workspace is already locked: %s", c.Name))
 	case string(didLockForCreate) == "false":
 		// Someone has the creation lock already. Release the existing workspace because it might not be safe to touch.
-		lockUnlock(string(pgLockId))
-		return "", &statemgr.LockError{Info: info, Err: fmt.Errorf("Cannot lock workspace; already locked for workspace creation: %s", c.Name)}
+		advisoryUnlock(ctx, conn, string(pgLockId))
+		return "", lockErr(fmt.Errorf("Cannot lock workspace; already locked for workspace creation: %s", c.Name))
 	default:
 		// Existing workspace is now locked. Release the attempted creation lock.
-		lockUnlock("-1")
-		info.Path = string(pgLockId)
+		advisoryUnlock(ctx, conn, "-1")
+		return string(pgLockId), nil
 	}
-	c.info = info
+}
 
-	return info.ID, nil
+// writeLockInfo records the given lock info for the workspace, replacing
+// any record left behind by a session that ended without unlocking.
+func (c *RemoteClient) writeLockInfo(ctx context.Context, conn *sql.Conn, info *statemgr.LockInfo) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO %s.%s (name, lock_id, info) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE
+		SET lock_id = $2, info = $3 WHERE %s.name = $1`
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(query, c.SchemaName, locksTableName, locksTableName), c.Name, info.ID, string(info.Marshal()))
+	if isUndefinedTable(err) {
+		// The locks table is only missing if table creation was skipped
+		// and it wasn't created manually, in which case we still lock the
+		// workspace but can't report who holds the lock.
+		log.Printf("[WARN] pg backend: not recording lock info because %s.%s doesn't exist", c.SchemaName, locksTableName)
+		return tx.Rollback()
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// storedLockInfo returns the recorded lock info for the workspace, or nil if
+// there is none.
+func (c *RemoteClient) storedLockInfo(ctx context.Context, db interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}) (*statemgr.LockInfo, error) {
+	query := `SELECT info FROM %s.%s WHERE name = $1`
+	row := db.QueryRowContext(ctx, fmt.Sprintf(query, c.SchemaName, locksTableName), c.Name)
+	var data string
+	err := row.Scan(&data)
+	switch {
+	case err == sql.ErrNoRows || isUndefinedTable(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	info := &statemgr.LockInfo{}
+	if err := json.Unmarshal([]byte(data), info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (c *RemoteClient) startKeepalive(conn *sql.Conn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(lockKeepaliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := conn.PingContext(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("[ERROR] pg backend: lost the connection holding the lock on workspace %q: %s", c.Name, err)
+					c.lockLost = err
+					return
+				}
+			}
+		}
+	}()
+	c.stopKeepalive = func() {
+		cancel()
+		<-done
+	}
 }
 
 func (c *RemoteClient) getLockInfo() (*statemgr.LockInfo, error) {
@@ -133,15 +256,142 @@ func (c *RemoteClient) getLockInfo() (*statemgr.LockInfo, error) {
 }
 
 func (c *RemoteClient) Unlock(id string) error {
-	if c.info != nil && c.info.Path != "" {
-		query := `SELECT pg_advisory_unlock(%s)`
-		row := c.Client.QueryRow(fmt.Sprintf(query, c.info.Path))
-		var didUnlock []byte
-		err := row.Scan(&didUnlock)
-		if err != nil {
-			return &statemgr.LockError{Info: c.info, Err: err}
+	ctx := context.Background()
+
+	if c.lockConn == nil {
+		return c.unlockOther(ctx, id)
+	}
+
+	if id != c.info.ID {
+		return &statemgr.LockError{Info: c.info, Err: fmt.Errorf("lock ID %q does not match existing lock", id)}
+	}
+
+	info := c.info
+	conn := c.lockConn
+	c.stopKeepalive()
+	lost := c.lockLost
+	c.info = nil
+	c.lockConn = nil
+	c.stopKeepalive = nil
+	c.lockLost = nil
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err == nil {
+		err = c.deleteLockInfo(ctx, tx, info.ID)
+		if err == nil {
+			err = advisoryUnlock(ctx, tx, info.Path)
+		}
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			tx.Rollback()
+		}
+	}
+	if err != nil {
+		// If the connection holding the lock was lost then the database has
+		// already released the advisory lock along with the session, so
+		// only the record of the lock is left to clean up.
+		log.Printf("[WARN] pg backend: failed to unlock on the connection holding the lock for workspace %q: %s", c.Name, err)
+		if err := c.deleteLockInfo(ctx, c.Client, info.ID); err != nil {
+			return &statemgr.LockError{Info: info, Err: err}
+		}
+		if lost == nil {
+			lost = err
 		}
-		c.info = nil
+	}
+	if lost != nil {
+		return &statemgr.LockError{Info: info, Err: fmt.Errorf("the lock on workspace %s was released early because the connection holding it failed, so the state may have been changed by others since then: %w", c.Name, lost)}
 	}
 	return nil
 }
+
+// unlockOther handles a request to unlock a lock that isn't held by this
+// client, such as when using force-unlock.
+func (c *RemoteClient) unlockOther(ctx context.Context, id string) error {
+	existing, err := c.storedLockInfo(ctx, c.Client)
+	if err != nil {
+		return &statemgr.LockError{Err: err}
+	}
+	if existing != nil && existing.ID != id {
+		return &statemgr.LockError{Info: existing, Err: fmt.Errorf("lock ID %q does not match existing lock", id)}
+	}
+
+	// Only the session holding an advisory lock can release it, so all we
+	// can do here is clean up a record left behind by a session that ended
+	// without unlocking. If we can take the lock ourselves, nobody else
+	// holds it.
+	conn, err := c.Client.Conn(ctx)
+	if err != nil {
+		return &statemgr.LockError{Info: existing, Err: err}
+	}
+	defer conn.Close()
+
+	// Without a record of the lock, such as when the locks table doesn't
+	// exist, we can't check the lock ID, but we can still tell whether
+	// the workspace is locked.
+	var key string
+	if existing != nil {
+		key = existing.Path
+	} else if key, err = c.advisoryLockKey(ctx, conn); err != nil {
+		return &statemgr.LockError{Err: err}
+	}
+
+	var didLock []byte
+	query := `SELECT pg_try_advisory_lock(%s)`
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf(query, key)).Scan(&didLock); err != nil {
+		return &statemgr.LockError{Info: existing, Err: err}
+	}
+	if string(didLock) == "false" {
+		return &statemgr.LockError{Info: existing, Err: fmt.Errorf("the lock is held by another active database session and can only be released by that session")}
+	}
+	defer advisoryUnlock(ctx, conn, key)
+
+	if existing == nil {
+		log.Printf("[INFO] pg backend: workspace %q isn't locked and no lock is recorded for it, so there is nothing to unlock", c.Name)
+		return nil
+	}
+	if err := c.deleteLockInfo(ctx, conn, id); err != nil {
+		return &statemgr.LockError{Info: existing, Err: err}
+	}
+	return nil
+}
+
+// advisoryLockKey returns the key of the advisory lock Lock takes for the
+// workspace: its row ID, or the creation lock if it doesn't exist yet.
+func (c *RemoteClient) advisoryLockKey(ctx context.Context, conn *sql.Conn) (string, error) {
+	query := `SELECT id FROM %s.%s WHERE name = $1`
+	var id []byte
+	err := conn.QueryRowContext(ctx, fmt.Sprintf(query, c.SchemaName, statesTableName), c.Name).Scan(&id)
+	switch {
+	case err == sql.ErrNoRows:
+		return "-1", nil
+	case err != nil:
+		return "", err
+	}
+	return string(id), nil
+}
+
+func (c *RemoteClient) deleteLockInfo(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}, id string) error {
+	query := `DELETE FROM %s.%s WHERE name = $1 AND lock_id = $2`
+	_, err := db.ExecContext(ctx, fmt.Sprintf(query, c.SchemaName, locksTableName), c.Name, id)
+	if isUndefinedTable(err) {
+		return nil
+	}
+	return err
+}
+
+func advisoryUnlock(ctx context.Context, db interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}, pgLockId string) error {
+	query := `SELECT pg_advisory_unlock(%s)`
+	var didUnlock []byte
+	return db.QueryRowContext(ctx, fmt.Sprintf(query, pgLockId)).Scan(&didUnlock)
+}
+
+func isUndefinedTable(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "42P01"
+}
@@ -9,13 +9,18 @@ package pg
 // TF_ACC=1 GO111MODULE=on go test -v -mod=vendor -timeout=2m -parallel=4 github.com/opentofu/opentofu/backend/remote-state/pg
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/states/remote"
+	"github.com/opentofu/opentofu/internal/states/statemgr"
 )
 
 func TestRemoteClient_impl(t *testing.T) {
@@ -80,3 +85,193 @@ func TestRemoteLocks(t *testing.T) {
 
 	remote.TestRemoteLocks(t, s1.(*remote.State).Client, s2.(*remote.State).Client)
 }
+
+func testRemoteClients(t *testing.T) (*RemoteClient, *RemoteClient, *sql.DB) {
+	t.Helper()
+	connStr := getDatabaseUrl()
+	schemaName := fmt.Sprintf("terraform_%s", t.Name())
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Query(fmt.Sprintf("DROP SCHEMA IF EXISTS %s CASCADE", schemaName))
+	})
+
+	config := backend.TestWrapConfig(map[string]interface{}{
+		"conn_str":    connStr,
+		"schema_name": schemaName,
+	})
+
+	b1 := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), config).(*Backend)
+	s1, err := b1.StateMgr(backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b2 := backend.TestBackendConfig(t, New(encryption.StateEncryptionDisabled()), config).(*Backend)
+	s2, err := b2.StateMgr(backend.DefaultStateName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s1.(*remote.State).Client.(*RemoteClient), s2.(*remote.State).Client.(*RemoteClient), db
+}
+
+func TestRemoteLocks_droppedConnection(t *testing.T) {
+	testACC(t)
+	c1, c2, db := testRemoteClients(t)
+
+	info := statemgr.NewLockInfo()
+	info.Operation = "test"
+	info.Who = "c1"
+	lockID, err := c1.Lock(info)
+	if err != nil {
+		t.Fatalf("failed to lock: %s", err)
+	}
+
+	if _, err := c2.Lock(statemgr.NewLockInfo()); err == nil {
+		t.Fatal("second client locked the workspace while the first held the lock")
+	} else {
+		var lockErr *statemgr.LockError
+		if !errors.As(err, &lockErr) {
+			t.Fatalf("expected a LockError, got %T: %s", err, err)
+		}
+		if lockErr.Info == nil || lockErr.Info.ID != lockID || lockErr.Info.Who != "c1" {
+			t.Fatalf("LockError doesn't describe the existing lock: %#v", lockErr.Info)
+		}
+	}
+
+	// Simulate the connection holding the lock being dropped, such as when
+	// the process holding the lock is killed or loses its network.
+	var pid int
+	if err := c1.lockConn.QueryRowContext(context.Background(), "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("SELECT pg_terminate_backend($1)", pid); err != nil {
+		t.Fatal(err)
+	}
+
+	// The database releases the lock along with the session, so the
+	// workspace must not stay locked.
+	info2 := statemgr.NewLockInfo()
+	info2.Operation = "test"
+	info2.Who = "c2"
+	lockID2, err := c2.Lock(info2)
+	if err != nil {
+		t.Fatalf("failed to lock after the connection holding the lock was dropped: %s", err)
+	}
+
+	// Unlocking on the dropped connection must report that the lock was
+	// lost, and must not remove the new lock.
+	if err := c1.Unlock(lockID); err == nil || !strings.Contains(err.Error(), "released early") {
+		t.Fatalf("unlocking after the connection was dropped didn't report the lost lock: %v", err)
+	}
+	stored, err := c2.storedLockInfo(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored == nil || stored.ID != lockID2 {
+		t.Fatalf("wrong stored lock info: %#v", stored)
+	}
+
+	if err := c2.Unlock(lockID2); err != nil {
+		t.Fatalf("failed to unlock: %s", err)
+	}
+}
+
+func TestRemoteLocks_keepaliveLost(t *testing.T) {
+	testACC(t)
+	c1, _, db := testRemoteClients(t)
+
+	defer func(orig time.Duration) { lockKeepaliveInterval = orig }(lockKeepaliveInterval)
+	lockKeepaliveInterval = 10 * time.Millisecond
+
+	info := statemgr.NewLockInfo()
+	info.Operation = "test"
+	lockID, err := c1.Lock(info)
+	if err != nil {
+		t.Fatalf("failed to lock: %s", err)
+	}
+
+	var pid int
+	if err := c1.lockConn.QueryRowContext(context.Background(), "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("SELECT pg_terminate_backend($1)", pid); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if err := c1.Unlock(lockID); err == nil || !strings.Contains(err.Error(), "released early") {
+		t.Fatalf("unlocking after the keepalive failed didn't report the lost lock: %v", err)
+	}
+}
+
+func TestRemoteLocks_forceUnlockWithoutLocksTable(t *testing.T) {
+	testACC(t)
+	c1, c2, db := testRemoteClients(t)
+
+	// As with skip_table_creation when the locks table wasn't created.
+	if _, err := db.Exec(fmt.Sprintf("DROP TABLE %s.%s", c1.SchemaName, locksTableName)); err != nil {
+		t.Fatal(err)
+	}
+
+	info := statemgr.NewLockInfo()
+	info.Operation = "test"
+	lockID, err := c1.Lock(info)
+	if err != nil {
+		t.Fatalf("failed to lock: %s", err)
+	}
+
+	// Without a record we can't check the ID, but the lock held by an
+	// active session must still be respected.
+	if err := c2.Unlock(lockID); err == nil {
+		t.Fatal("unlocked a lock held by another active session")
+	}
+
+	if err := c1.Unlock(lockID); err != nil {
+		t.Fatalf("failed to unlock: %s", err)
+	}
+
+	// Once nobody holds the lock, force-unlock has nothing to do.
+	if err := c2.Unlock(lockID); err != nil {
+		t.Fatalf("failed to force-unlock an unlocked workspace: %s", err)
+	}
+}
+
+func TestRemoteLocks_mismatchedID(t *testing.T) {
+	testACC(t)
+	c1, c2, _ := testRemoteClients(t)
+
+	info := statemgr.NewLockInfo()
+	info.Operation = "test"
+	info.Who = "c1"
+	lockID, err := c1.Lock(info)
+	if err != nil {
+		t.Fatalf("failed to lock: %s", err)
+	}
+
+	for name, c := range map[string]*RemoteClient{"holder": c1, "other": c2} {
+		t.Run(name, func(t *testing.T) {
+			err := c.Unlock("not-" + lockID)
+			var lockErr *statemgr.LockError
+			if !errors.As(err, &lockErr) {
+				t.Fatalf("expected a LockError, got %T: %v", err, err)
+			}
+			if lockErr.Info == nil || lockErr.Info.ID != lockID || lockErr.Info.Who != "c1" {
+				t.Fatalf("LockError doesn't describe the existing lock: %#v", lockErr.Info)
+			}
+		})
+	}
+
+	// Another client can't release a lock held by an active session, even
+	// with the right ID.
+	if err := c2.Unlock(lockID); err == nil {
+		t.Fatal("unlocked a lock held by another active session")
+	}
+
+	if err := c1.Unlock(lockID); err != nil {
+		t.Fatalf("failed to unlock: %s", err)
+	}
+}
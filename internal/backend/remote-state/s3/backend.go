@@ -17,9 +17,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	awsbaseValidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
@@ -306,61 +309,16 @@ func (b *Backend) ConfigSchema() *configschema.Block {
 			"assume_role": {
 				Optional: true,
 				NestedType: &configschema.Object{
-					Nesting: configschema.NestingSingle,
-					Attributes: map[string]*configschema.Attribute{
-						"role_arn": {
-							Type:        cty.String,
-							Required:    true,
-							Description: "The role to be assumed.",
-						},
-						"duration": {
-							Type:        cty.String,
-							Optional:    true,
-							Description: "Seconds to restrict the assume role session duration.",
-						},
-						"external_id": {
-							Type:        cty.String,
-							Optional:    true,
-							Description: "The external ID to use when assuming the role",
-						},
-						"policy": {
-							Type:        cty.String,
-							Optional:    true,
-							Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"policy_arns": {
-							Type:        cty.Set(cty.String),
-							Optional:    true,
-							Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
-						},
-						"session_name": {
-							Type:        cty.String,
-							Optional:    true,
-							Description: "The session name to use when assuming the role.",
-						},
-						"tags": {
-							Type:        cty.Map(cty.String),
-							Optional:    true,
-							Description: "Assume role session tags.",
-						},
-						"transitive_tag_keys": {
-							Type:        cty.Set(cty.String),
-							Optional:    true,
-							Description: "Assume role session tag keys to pass to any subsequent sessions.",
-						},
-						//
-						// NOT SUPPORTED by `aws-sdk-go-base/v1`
-						// Cannot be added yet.
-						//
-						// "source_identity": stringAttribute{
-						// 	configschema.Attribute{
-						// 		Type:         cty.String,
-						// 		Optional:     true,
-						// 		Description:  "Source identity specified by the principal assuming the role.",
-						// 		ValidateFunc: validAssumeRoleSourceIdentity,
-						// 	},
-						// },
-					},
+					Nesting:    configschema.NestingSingle,
+					Attributes: assumeRoleAttributes(),
+				},
+			},
+			"assume_role_chain": {
+				Optional:    true,
+				Description: "A list of roles to assume in order, each using the credentials of the role before it.",
+				NestedType: &configschema.Object{
+					Nesting:    configschema.NestingList,
+					Attributes: assumeRoleAttributes(),
 				},
 			},
 			"assume_role_with_web_identity": {
@@ -457,6 +415,65 @@ See details: https://cs.opensource.google/go/x/net/+/refs/tags/v0.17.0:http/http
 	}
 }
 
+// assumeRoleAttributes returns the attributes of a role to assume, which are
+// the same for "assume_role" and for each of the roles in "assume_role_chain".
+func assumeRoleAttributes() map[string]*configschema.Attribute {
+	return map[string]*configschema.Attribute{
+		"role_arn": {
+			Type:        cty.String,
+			Required:    true,
+			Description: "The role to be assumed.",
+		},
+		"duration": {
+			Type:        cty.String,
+			Optional:    true,
+			Description: "Seconds to restrict the assume role session duration.",
+		},
+		"external_id": {
+			Type:        cty.String,
+			Optional:    true,
+			Description: "The external ID to use when assuming the role",
+		},
+		"policy": {
+			Type:        cty.String,
+			Optional:    true,
+			Description: "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+		},
+		"policy_arns": {
+			Type:        cty.Set(cty.String),
+			Optional:    true,
+			Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+		},
+		"session_name": {
+			Type:        cty.String,
+			Optional:    true,
+			Description: "The session name to use when assuming the role.",
+		},
+		"tags": {
+			Type:        cty.Map(cty.String),
+			Optional:    true,
+			Description: "Assume role session tags.",
+		},
+		"transitive_tag_keys": {
+			Type:        cty.Set(cty.String),
+			Optional:    true,
+			Description: "Assume role session tag keys to pass to any subsequent sessions.",
+		},
+		//
+		// NOT SUPPORTED by `aws-sdk-go-base/v1`
+		// Cannot be added yet.
+		//
+		// "source_identity": stringAttribute{
+		// 	configschema.Attribute{
+		// 		Type:         cty.String,
+		// 		Optional:     true,
+		// 		Description:  "Source identity specified by the principal assuming the role.",
+		// 		ValidateFunc: validAssumeRoleSourceIdentity,
+		// 	},
+		// },
+	}
+}
+
 // PrepareConfig checks the validity of the values in the given
 // configuration, and inserts any missing defaults, assuming that its
 // structure has already been validated per the schema returned by
@@ -585,14 +602,27 @@ func (b *Backend) PrepareConfig(obj cty.Value) (cty.Value, tfdiags.Diagnostics)
 		"assume_role_transitive_tag_keys": "assume_role.transitive_tag_keys",
 	}
 
+	var assumeRoleAttr string
 	if val := obj.GetAttr("assume_role"); !val.IsNull() {
 		diags = diags.Append(validateNestedAssumeRole(val, cty.Path{cty.GetAttrStep{Name: "assume_role"}}))
+		assumeRoleAttr = "assume_role"
+	}
+	if val := obj.GetAttr("assume_role_chain"); !val.IsNull() {
+		diags = diags.Append(validateAssumeRoleChain(val, cty.GetAttrPath("assume_role_chain")))
+		assumeRoleAttr = "assume_role_chain"
+	}
 
+	validateAttributesConflict(
+		cty.GetAttrPath("assume_role"),
+		cty.GetAttrPath("assume_role_chain"),
+	)(obj, cty.Path{}, &diags)
+
+	if assumeRoleAttr != "" {
 		if defined := findDeprecatedFields(obj, assumeRoleDeprecatedFields); len(defined) != 0 {
 			diags = diags.Append(tfdiags.WholeContainingBody(
 				tfdiags.Error,
 				"Conflicting Parameters",
-				`The following deprecated parameters conflict with the parameter "assume_role". Replace them as follows:`+"\n"+
+				fmt.Sprintf(`The following deprecated parameters conflict with the parameter %q. Replace them as follows:`, assumeRoleAttr)+"\n"+
 					formatDeprecated(defined),
 			))
 		}
@@ -771,8 +801,13 @@ func (b *Backend) Configure(obj cty.Value) tfdiags.Diagnostics {
 		cfg.SharedCredentialsFiles = []string{val}
 	}
 
+	var assumeRoleChain []*awsbase.AssumeRole
 	if value := obj.GetAttr("assume_role"); !value.IsNull() {
 		cfg.AssumeRole = configureNestedAssumeRole(obj)
+	} else if value := obj.GetAttr("assume_role_chain"); !value.IsNull() {
+		// The roles in the chain are assumed below, once the base
+		// credentials have been loaded.
+		assumeRoleChain = configureAssumeRoleChain(value)
 	} else if value := obj.GetAttr("role_arn"); !value.IsNull() {
 		cfg.AssumeRole = configureAssumeRole(obj)
 	}
@@ -814,6 +849,15 @@ func (b *Backend) Configure(obj cty.Value) tfdiags.Diagnostics {
 		))
 	}
 
+	if len(assumeRoleChain) != 0 && !diags.HasErrors() {
+		var chainDiags tfdiags.Diagnostics
+		awsConfig, chainDiags = assumeRoleChainCredentials(ctx, awsConfig, cfg, assumeRoleChain)
+		diags = diags.Append(chainDiags)
+		if diags.HasErrors() {
+			return diags
+		}
+	}
+
 	if d := verifyAllowedAccountID(ctx, awsConfig, cfg); len(d) != 0 {
 		diags = diags.Append(d)
 	}
@@ -886,9 +930,22 @@ func getS3Config(obj cty.Value) func(options *s3.Options) {
 }
 
 func configureNestedAssumeRole(obj cty.Value) *awsbase.AssumeRole {
+	return configureAssumeRoleObject(obj.GetAttr("assume_role"))
+}
+
+func configureAssumeRoleChain(val cty.Value) []*awsbase.AssumeRole {
+	var chain []*awsbase.AssumeRole
+	for _, obj := range val.AsValueSlice() {
+		chain = append(chain, configureAssumeRoleObject(obj))
+	}
+	return chain
+}
+
+// configureAssumeRoleObject returns the role to assume described by an
+// object in the form of the "assume_role" attribute.
+func configureAssumeRoleObject(obj cty.Value) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
-	obj = obj.GetAttr("assume_role")
 	if val, ok := stringAttrOk(obj, "role_arn"); ok {
 		assumeRole.RoleARN = val
 	}
@@ -947,6 +1004,69 @@ func configureAssumeRole(obj cty.Value) *awsbase.AssumeRole {
 	return &assumeRole
 }
 
+// assumeRoleChainCredentials assumes each of the roles in the chain in turn,
+// starting with the credentials in awsConfig, and returns a copy of
+// awsConfig that uses the credentials of the last role.
+func assumeRoleChainCredentials(ctx context.Context, awsConfig aws.Config, cfg *awsbase.Config, chain []*awsbase.AssumeRole) (aws.Config, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	for i, ar := range chain {
+		log.Printf("[INFO] Assuming IAM Role %q (%d of %d in assume_role_chain)", ar.RoleARN, i+1, len(chain))
+
+		client := sts.NewFromConfig(awsConfig, func(opts *sts.Options) {
+			if cfg.StsRegion != "" {
+				opts.Region = cfg.StsRegion
+			}
+			if cfg.StsEndpoint != "" {
+				opts.BaseEndpoint = aws.String(cfg.StsEndpoint)
+			}
+		})
+		// The credentials are retrieved through the cache so that the role
+		// is only assumed once, both here and when they are used later.
+		provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, assumeRoleOptions(ar)))
+		if _, err := provider.Retrieve(ctx); err != nil {
+			diags = diags.Append(attributeErrDiag(
+				"Cannot assume IAM Role",
+				fmt.Sprintf("Failed to assume role %d of %d in the chain, %q: %s", i+1, len(chain), ar.RoleARN, err),
+				cty.GetAttrPath("assume_role_chain").IndexInt(i),
+			))
+			return awsConfig, diags
+		}
+
+		// Each role is assumed using the credentials of the one before it,
+		// and the last one is used for everything else.
+		awsConfig = awsConfig.Copy()
+		awsConfig.Credentials = provider
+	}
+
+	return awsConfig, diags
+}
+
+func assumeRoleOptions(ar *awsbase.AssumeRole) func(*stscreds.AssumeRoleOptions) {
+	return func(opts *stscreds.AssumeRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+		if ar.ExternalID != "" {
+			opts.ExternalID = aws.String(ar.ExternalID)
+		}
+		if ar.Policy != "" {
+			opts.Policy = aws.String(ar.Policy)
+		}
+		for _, arn := range ar.PolicyARNs {
+			opts.PolicyARNs = append(opts.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws.String(arn)})
+		}
+		keys := make([]string, 0, len(ar.Tags))
+		for k := range ar.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			opts.Tags = append(opts.Tags, ststypes.Tag{Key: aws.String(k), Value: aws.String(ar.Tags[k])})
+		}
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+}
+
 func configureAssumeRoleWithWebIdentity(obj cty.Value) *awsbase.AssumeRoleWithWebIdentity {
 	cfg := &awsbase.AssumeRoleWithWebIdentity{
 		RoleARN:              stringAttrDefaultEnvVar(obj, "role_arn", "AWS_ROLE_ARN"),
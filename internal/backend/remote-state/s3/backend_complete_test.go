@@ -8,6 +8,8 @@ package s3
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -1597,6 +1599,155 @@ aws_secret_access_key = DefaultSharedCredentialsSecretKey
 	}
 }
 
+func TestBackendConfig_Authentication_AssumeRoleChain(t *testing.T) {
+	const intermediateRoleARN = "arn:aws:iam::111111111111:role/intermediate"
+	const intermediateSessionName = "intermediate-session"
+
+	testCases := map[string]struct {
+		config                   map[string]any
+		ExpectedCredentialsValue aws.Credentials
+		MockStsEndpoints         []*servicemocks.MockEndpoint
+		ValidateDiags            diagsValidator
+	}{
+		"two roles": {
+			config: map[string]any{
+				"access_key": servicemocks.MockStaticAccessKey,
+				"secret_key": servicemocks.MockStaticSecretKey,
+				"assume_role_chain": []any{
+					map[string]any{
+						"role_arn":     intermediateRoleARN,
+						"session_name": intermediateSessionName,
+					},
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				mockStsAssumeRoleEndpoint(intermediateRoleARN, intermediateSessionName),
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsGetCallerIdentityValidEndpoint,
+			},
+		},
+
+		"second role fails": {
+			config: map[string]any{
+				"access_key": servicemocks.MockStaticAccessKey,
+				"secret_key": servicemocks.MockStaticSecretKey,
+				"assume_role_chain": []any{
+					map[string]any{
+						"role_arn":     intermediateRoleARN,
+						"session_name": intermediateSessionName,
+					},
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+			},
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				mockStsAssumeRoleEndpoint(intermediateRoleARN, intermediateSessionName),
+				servicemocks.MockStsAssumeRoleInvalidEndpointInvalidClientTokenId,
+				servicemocks.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ValidateDiags: ExpectDiagsMatching(
+				tfdiags.Error,
+				equalsMatcher("Cannot assume IAM Role"),
+				newRegexpMatcher(fmt.Sprintf(`^Failed to assume role 2 of 2 in the chain, %q: `, servicemocks.MockStsAssumeRoleArn)),
+			),
+		},
+
+		"first role fails": {
+			config: map[string]any{
+				"access_key": servicemocks.MockStaticAccessKey,
+				"secret_key": servicemocks.MockStaticSecretKey,
+				"assume_role_chain": []any{
+					map[string]any{
+						"role_arn":     intermediateRoleARN,
+						"session_name": intermediateSessionName,
+					},
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+				},
+			},
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ValidateDiags: ExpectDiagsMatching(
+				tfdiags.Error,
+				equalsMatcher("Cannot assume IAM Role"),
+				newRegexpMatcher(fmt.Sprintf(`^Failed to assume role 1 of 2 in the chain, %q: `, intermediateRoleARN)),
+			),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			ctx := context.TODO()
+
+			// Populate required fields
+			tc.config["region"] = "us-east-1"
+			tc.config["bucket"] = "bucket"
+			tc.config["key"] = "key"
+
+			if tc.ValidateDiags == nil {
+				tc.ValidateDiags = ExpectNoDiags
+			}
+
+			ts := servicemocks.MockAwsApiServer("STS", tc.MockStsEndpoints)
+			defer ts.Close()
+
+			tc.config["sts_endpoint"] = ts.URL
+
+			b, diags := configureBackend(t, tc.config)
+
+			tc.ValidateDiags(t, diags)
+
+			if diags.HasErrors() {
+				return
+			}
+
+			credentials, err := b.awsConfig.Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("Error when requesting credentials: %s", err)
+			}
+
+			if diff := cmp.Diff(credentials, tc.ExpectedCredentialsValue, cmpopts.IgnoreFields(aws.Credentials{}, "Expires")); diff != "" {
+				t.Fatalf("unexpected credentials: (- got, + expected)\n%s", diff)
+			}
+		})
+	}
+}
+
+// mockStsAssumeRoleEndpoint returns an endpoint that responds to a request
+// to assume the given role in the same way as
+// servicemocks.MockStsAssumeRoleValidEndpoint.
+func mockStsAssumeRoleEndpoint(roleARN, sessionName string) *servicemocks.MockEndpoint {
+	return &servicemocks.MockEndpoint{
+		Request: &servicemocks.MockRequest{
+			Body: url.Values{
+				"Action":          []string{"AssumeRole"},
+				"DurationSeconds": []string{"900"},
+				"RoleArn":         []string{roleARN},
+				"RoleSessionName": []string{sessionName},
+				"Version":         []string{"2011-06-15"},
+			}.Encode(),
+			Method: http.MethodPost,
+			Uri:    "/",
+		},
+		Response: servicemocks.MockStsAssumeRoleValidEndpoint.Response,
+	}
+}
+
 func TestBackendConfig_Authentication_AssumeRoleWithWebIdentity(t *testing.T) {
 	testCases := map[string]struct {
 		config                          map[string]any
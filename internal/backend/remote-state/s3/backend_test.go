@@ -771,6 +771,48 @@ func TestBackendConfig_PrepareConfigValidation(t *testing.T) {
 			}),
 			expectedErr: `Invalid Attribute Combination: Only one of endpoints.dynamodb, dynamodb_endpoint can be set.`,
 		},
+		"assume_role_chain conflict": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"bucket": cty.StringVal("test"),
+				"key":    cty.StringVal("test"),
+				"region": cty.StringVal("us-west-2"),
+				"assume_role": cty.ObjectVal(map[string]cty.Value{
+					"role_arn": cty.StringVal("arn:aws:iam::123456789012:role/a"),
+				}),
+				"assume_role_chain": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"role_arn": cty.StringVal("arn:aws:iam::123456789012:role/b"),
+					}),
+				}),
+			}),
+			expectedErr: `Invalid Attribute Combination: Only one of assume_role, assume_role_chain can be set.`,
+		},
+		"assume_role_chain empty": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"bucket":            cty.StringVal("test"),
+				"key":               cty.StringVal("test"),
+				"region":            cty.StringVal("us-west-2"),
+				"assume_role_chain": cty.EmptyTupleVal,
+			}),
+			expectedErr: `The attribute "assume_role_chain" must contain at least one role.`,
+		},
+		"assume_role_chain invalid duration": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"bucket": cty.StringVal("test"),
+				"key":    cty.StringVal("test"),
+				"region": cty.StringVal("us-west-2"),
+				"assume_role_chain": cty.TupleVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"role_arn": cty.StringVal("arn:aws:iam::123456789012:role/a"),
+					}),
+					cty.ObjectVal(map[string]cty.Value{
+						"role_arn": cty.StringVal("arn:aws:iam::123456789012:role/b"),
+						"duration": cty.StringVal("1s"),
+					}),
+				}),
+			}),
+			expectedErr: `Invalid Duration: Duration must be between 15m0s and 12h0m0s, had 1s`,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1727,8 +1769,8 @@ func unmarshal(value cty.Value, ty cty.Type, path cty.Path) (cty.Value, error) {
 	switch {
 	case ty.IsPrimitiveType():
 		return value, nil
-	// case ty.IsListType():
-	// 	return unmarshalList(value, ty.ElementType(), path)
+	case ty.IsListType():
+		return unmarshalList(value, ty.ElementType(), path)
 	case ty.IsSetType():
 		return unmarshalSet(value, ty.ElementType(), path)
 	case ty.IsMapType():
@@ -1762,6 +1804,33 @@ func unmarshalSet(dec cty.Value, ety cty.Type, path cty.Path) (cty.Value, error)
 	return cty.SetVal(vals), nil
 }
 
+func unmarshalList(dec cty.Value, ety cty.Type, path cty.Path) (cty.Value, error) {
+	if dec.IsNull() {
+		return dec, nil
+	}
+
+	length := dec.LengthInt()
+
+	if length == 0 {
+		return cty.ListValEmpty(ety), nil
+	}
+
+	vals := make([]cty.Value, 0, length)
+	path = append(path, nil)
+	for i, elem := range dec.AsValueSlice() {
+		path[len(path)-1] = cty.IndexStep{
+			Key: cty.NumberIntVal(int64(i)),
+		}
+		val, err := unmarshal(elem, ety, path)
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		vals = append(vals, val)
+	}
+
+	return cty.ListVal(vals), nil
+}
+
 func unmarshalMap(dec cty.Value, ety cty.Type, path cty.Path) (cty.Value, error) {
 	if dec.IsNull() {
 		return dec, nil
@@ -189,6 +189,15 @@ func (c *RemoteClient) get(ctx context.Context) (*remote.Payload, error) {
 	return payload, nil
 }
 
+// maxPutObjectSize is the largest object that S3 accepts in a single
+// PutObject request.
+const maxPutObjectSize = 5 * 1024 * 1024 * 1024
+
+// MaxStateSize implements statemgr.SizeLimiter.
+func (c *RemoteClient) MaxStateSize() int64 {
+	return maxPutObjectSize
+}
+
 func (c *RemoteClient) Put(data []byte) error {
 	contentType := "application/json"
 	contentLength := int64(len(data))
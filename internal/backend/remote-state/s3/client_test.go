@@ -24,6 +24,7 @@ import (
 func TestRemoteClient_impl(t *testing.T) {
 	var _ remote.Client = new(RemoteClient)
 	var _ remote.ClientLocker = new(RemoteClient)
+	var _ statemgr.SizeLimiter = new(RemoteClient)
 }
 
 func TestRemoteClient(t *testing.T) {
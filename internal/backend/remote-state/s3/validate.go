@@ -105,6 +105,25 @@ func validateNestedAssumeRole(obj cty.Value, objPath cty.Path) tfdiags.Diagnosti
 	return diags
 }
 
+func validateAssumeRoleChain(obj cty.Value, objPath cty.Path) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	if obj.LengthInt() == 0 {
+		diags = diags.Append(attributeErrDiag(
+			"Invalid Value",
+			fmt.Sprintf("The attribute %q must contain at least one role.", pathString(objPath)),
+			objPath,
+		))
+		return diags
+	}
+
+	for i, val := range obj.AsValueSlice() {
+		diags = diags.Append(validateNestedAssumeRole(val, objPath.IndexInt(i)))
+	}
+
+	return diags
+}
+
 func validateAssumeRoleWithWebIdentity(obj cty.Value, objPath cty.Path) tfdiags.Diagnostics {
 	var diags tfdiags.Diagnostics
 
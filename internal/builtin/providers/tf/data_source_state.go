@@ -6,14 +6,17 @@
 package tf
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"path"
 
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/backend/remote"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
 
@@ -62,6 +65,31 @@ func dataSourceRemoteStateGetSchema() providers.Schema {
 					DescriptionKind: configschema.StringMarkdown,
 					Optional:        true,
 				},
+				"workspace_pattern": {
+					Type: cty.String,
+					Description: "A glob pattern selecting several workspaces " +
+						"to read at once, such as `prod-*`. The backend must " +
+						"support listing its workspaces. Conflicts with `workspace`.",
+					DescriptionKind: configschema.StringMarkdown,
+					Optional:        true,
+				},
+				"workspace_outputs": {
+					Type: cty.DynamicPseudoType,
+					Description: "When `workspace_pattern` is set, an object " +
+						"with an attribute for each matching workspace, " +
+						"containing every root-level output in that " +
+						"workspace's state.",
+					DescriptionKind: configschema.StringMarkdown,
+					Computed:        true,
+				},
+				"workspace_errors": {
+					Type: cty.Map(cty.String),
+					Description: "When `workspace_pattern` is set, a map from " +
+						"the name of each matching workspace that could not " +
+						"be read to the reason why.",
+					DescriptionKind: configschema.StringMarkdown,
+					Computed:        true,
+				},
 			},
 		},
 	}
@@ -100,6 +128,27 @@ func dataSourceRemoteStateValidate(cfg cty.Value) tfdiags.Diagnostics {
 		}
 	}
 
+	if patternVal := cfg.GetAttr("workspace_pattern"); !patternVal.IsNull() {
+		if !cfg.GetAttr("workspace").IsNull() {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Conflicting workspace arguments",
+				"Only one of \"workspace\" and \"workspace_pattern\" may be set.",
+				cty.GetAttrPath("workspace_pattern"),
+			))
+		}
+		if patternVal.IsKnown() {
+			if _, err := path.Match(patternVal.AsString(), ""); err != nil {
+				diags = diags.Append(tfdiags.AttributeValue(
+					tfdiags.Error,
+					"Invalid workspace pattern",
+					fmt.Sprintf("The workspace pattern %q is not a valid glob pattern: %s.", patternVal.AsString(), err),
+					cty.GetAttrPath("workspace_pattern"),
+				))
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -123,9 +172,30 @@ func dataSourceRemoteStateRead(d cty.Value, enc encryption.StateEncryption) (cty
 	newState["config"] = d.GetAttr("config")
 
 	workspaceVal := d.GetAttr("workspace")
-	// This attribute is not computed, so we always have to store the state
+	// These attributes are not computed, so we always have to store the state
 	// value, even if we implicitly use a default.
 	newState["workspace"] = workspaceVal
+	newState["workspace_pattern"] = d.GetAttr("workspace_pattern")
+
+	defaultsVal := d.GetAttr("defaults")
+	if defaultsVal.IsNull() {
+		defaultsVal = cty.NullVal(cty.DynamicPseudoType)
+	}
+	newState["defaults"] = defaultsVal
+
+	if patternVal := d.GetAttr("workspace_pattern"); !patternVal.IsNull() {
+		newState["outputs"] = cty.NullVal(cty.DynamicPseudoType)
+		workspaceOutputs, workspaceErrors, moreDiags := readRemoteStateWorkspaces(b, patternVal.AsString(), defaultsVal)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return cty.NilVal, diags
+		}
+		newState["workspace_outputs"] = workspaceOutputs
+		newState["workspace_errors"] = workspaceErrors
+		return cty.ObjectVal(newState), diags
+	}
+	newState["workspace_outputs"] = cty.NullVal(cty.DynamicPseudoType)
+	newState["workspace_errors"] = cty.NullVal(cty.Map(cty.String))
 
 	workspaceName := backend.DefaultStateName
 	if !workspaceVal.IsNull() {
@@ -148,19 +218,6 @@ func dataSourceRemoteStateRead(d cty.Value, enc encryption.StateEncryption) (cty
 		return cty.NilVal, diags
 	}
 
-	outputs := make(map[string]cty.Value)
-
-	if defaultsVal := d.GetAttr("defaults"); !defaultsVal.IsNull() {
-		newState["defaults"] = defaultsVal
-		it := defaultsVal.ElementIterator()
-		for it.Next() {
-			k, v := it.Element()
-			outputs[k.AsString()] = v
-		}
-	} else {
-		newState["defaults"] = cty.NullVal(cty.DynamicPseudoType)
-	}
-
 	remoteState := state.State()
 	if remoteState == nil {
 		diags = diags.Append(tfdiags.AttributeValue(
@@ -172,6 +229,100 @@ func dataSourceRemoteStateRead(d cty.Value, enc encryption.StateEncryption) (cty
 		newState["outputs"] = cty.EmptyObjectVal
 		return cty.ObjectVal(newState), diags
 	}
+
+	newState["outputs"] = remoteStateOutputs(remoteState, defaultsVal)
+
+	return cty.ObjectVal(newState), diags
+}
+
+// readRemoteStateWorkspaces reads the outputs of each workspace of the given
+// backend whose name matches the given glob pattern.
+//
+// It returns an object with an attribute for each workspace that could be
+// read and a map of the reason why each of the others could not. Errors
+// reading an individual workspace are reported as warnings, so that one
+// workspace the caller isn't permitted to read doesn't hide all the others.
+func readRemoteStateWorkspaces(b backend.Backend, pattern string, defaultsVal cty.Value) (cty.Value, cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	workspaces, err := b.Workspaces()
+	if errors.Is(err, backend.ErrWorkspacesNotSupported) {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Backend does not support workspaces",
+			"The selected backend cannot list its workspaces, so \"workspace_pattern\" cannot be used with it. Use \"workspace\" to read a single workspace instead.",
+			cty.Path(nil).GetAttr("workspace_pattern"),
+		))
+		return cty.NilVal, cty.NilVal, diags
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Error listing workspaces",
+			fmt.Sprintf("error listing the workspaces of the remote backend: %s", err),
+			cty.Path(nil).GetAttr("backend"),
+		))
+		return cty.NilVal, cty.NilVal, diags
+	}
+
+	outputs := make(map[string]cty.Value)
+	errs := make(map[string]cty.Value)
+	for _, name := range workspaces {
+		if matched, _ := path.Match(pattern, name); !matched {
+			continue
+		}
+
+		remoteState, err := readRemoteStateWorkspace(b, name)
+		if err != nil {
+			log.Printf("[WARN] terraform_remote_state: failed to read workspace %q: %s", name, err)
+			errs[name] = cty.StringVal(err.Error())
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Warning,
+				"Unable to read remote workspace",
+				fmt.Sprintf("The outputs of workspace %q are not available: %s.", name, err),
+				cty.Path(nil).GetAttr("workspace_pattern"),
+			))
+			continue
+		}
+		outputs[name] = remoteStateOutputs(remoteState, defaultsVal)
+	}
+
+	errsVal := cty.MapValEmpty(cty.String)
+	if len(errs) > 0 {
+		errsVal = cty.MapVal(errs)
+	}
+	return cty.ObjectVal(outputs), errsVal, diags
+}
+
+func readRemoteStateWorkspace(b backend.Backend, workspaceName string) (*states.State, error) {
+	state, err := b.StateMgr(workspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the remote state: %w", err)
+	}
+	if err := state.RefreshState(); err != nil {
+		return nil, err
+	}
+	remoteState := state.State()
+	if remoteState == nil {
+		return nil, errors.New("no stored state was found for this workspace")
+	}
+	return remoteState, nil
+}
+
+// remoteStateOutputs returns an object containing the root module outputs of
+// the given state, falling back on the given defaults for any that are not
+// present.
+func remoteStateOutputs(remoteState *states.State, defaultsVal cty.Value) cty.Value {
+	outputs := make(map[string]cty.Value)
+
+	if !defaultsVal.IsNull() {
+		it := defaultsVal.ElementIterator()
+		for it.Next() {
+			k, v := it.Element()
+			outputs[k.AsString()] = v
+		}
+	}
+
 	mod := remoteState.RootModule()
 	if mod != nil { // should always have a root module in any valid state
 		for k, os := range mod.OutputValues {
@@ -179,9 +330,7 @@ func dataSourceRemoteStateRead(d cty.Value, enc encryption.StateEncryption) (cty
 		}
 	}
 
-	newState["outputs"] = cty.ObjectVal(outputs)
-
-	return cty.ObjectVal(newState), diags
+	return cty.ObjectVal(outputs)
 }
 
 func getBackend(cfg cty.Value, enc encryption.StateEncryption) (backend.Backend, cty.Value, tfdiags.Diagnostics) {
@@ -8,12 +8,16 @@ package tf
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/apparentlymart/go-dump/dump"
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
@@ -46,8 +50,11 @@ func TestState_basic(t *testing.T) {
 				"outputs": cty.ObjectVal(map[string]cty.Value{
 					"foo": cty.StringVal("bar"),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -68,7 +75,10 @@ func TestState_basic(t *testing.T) {
 				"outputs": cty.ObjectVal(map[string]cty.Value{
 					"foo": cty.StringVal("bar"),
 				}),
-				"defaults": cty.NullVal(cty.DynamicPseudoType),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -87,8 +97,11 @@ func TestState_basic(t *testing.T) {
 				"outputs": cty.ObjectVal(map[string]cty.Value{
 					"foo": cty.StringVal("bar"),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -121,8 +134,11 @@ func TestState_basic(t *testing.T) {
 						cty.StringVal("test2"),
 					}),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -142,8 +158,11 @@ func TestState_basic(t *testing.T) {
 					"map":  cty.NullVal(cty.Map(cty.String)),
 					"list": cty.NullVal(cty.List(cty.String)),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -168,7 +187,10 @@ func TestState_basic(t *testing.T) {
 				"outputs": cty.ObjectVal(map[string]cty.Value{
 					"foo": cty.StringVal("bar"),
 				}),
-				"workspace": cty.NullVal(cty.String),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -184,9 +206,12 @@ func TestState_basic(t *testing.T) {
 				"config": cty.ObjectVal(map[string]cty.Value{
 					"path": cty.StringVal("./testdata/missing.tfstate"),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"outputs":   cty.EmptyObjectVal,
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"outputs":           cty.EmptyObjectVal,
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			true,
 		},
@@ -237,9 +262,12 @@ func TestState_basic(t *testing.T) {
 				"config": cty.MapVal(map[string]cty.Value{
 					"path": cty.StringVal("./testdata/empty.tfstate"),
 				}),
-				"defaults":  cty.NullVal(cty.DynamicPseudoType),
-				"outputs":   cty.EmptyObjectVal,
-				"workspace": cty.NullVal(cty.String),
+				"defaults":          cty.NullVal(cty.DynamicPseudoType),
+				"outputs":           cty.EmptyObjectVal,
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -260,7 +288,10 @@ func TestState_basic(t *testing.T) {
 				"outputs": cty.ObjectVal(map[string]cty.Value{
 					"foo": cty.StringVal("bar"),
 				}),
-				"workspace": cty.NullVal(cty.String),
+				"workspace":         cty.NullVal(cty.String),
+				"workspace_pattern": cty.NullVal(cty.String),
+				"workspace_outputs": cty.NullVal(cty.DynamicPseudoType),
+				"workspace_errors":  cty.NullVal(cty.Map(cty.String)),
 			}),
 			false,
 		},
@@ -274,6 +305,23 @@ func TestState_basic(t *testing.T) {
 			cty.NilVal,
 			true,
 		},
+		"workspace and workspace_pattern": {
+			cty.ObjectVal(map[string]cty.Value{
+				"backend":           cty.StringVal("local"),
+				"workspace":         cty.StringVal(backend.DefaultStateName),
+				"workspace_pattern": cty.StringVal("*"),
+			}),
+			cty.NilVal,
+			true,
+		},
+		"invalid workspace_pattern": {
+			cty.ObjectVal(map[string]cty.Value{
+				"backend":           cty.StringVal("local"),
+				"workspace_pattern": cty.StringVal("prod-["),
+			}),
+			cty.NilVal,
+			true,
+		},
 		"null config": {
 			cty.ObjectVal(map[string]cty.Value{
 				"backend": cty.StringVal("local"),
@@ -345,6 +393,136 @@ func TestState_validation(t *testing.T) {
 	}
 }
 
+func TestState_workspacePattern(t *testing.T) {
+	outputsState := func(value string) *states.State {
+		return states.BuildState(func(s *states.SyncState) {
+			s.SetOutputValue(addrs.OutputValue{Name: "name"}.Absolute(addrs.RootModuleInstance), cty.StringVal(value), false)
+		})
+	}
+	overrideBackendFactories = map[string]backend.InitFn{
+		"workspaces": func(enc encryption.StateEncryption) backend.Backend {
+			return backendWorkspaces{
+				states: map[string]*states.State{
+					backend.DefaultStateName: outputsState("default"),
+					"prod-eu":                outputsState("prod-eu"),
+					"prod-us":                outputsState("prod-us"),
+					"staging":                outputsState("staging"),
+				},
+				denied: map[string]bool{"prod-us": true},
+			}
+		},
+		"noworkspaces": func(enc encryption.StateEncryption) backend.Backend {
+			return backendWorkspaces{workspacesErr: backend.ErrWorkspacesNotSupported}
+		},
+	}
+	defer func() {
+		// undo our overrides so we won't affect other tests
+		overrideBackendFactories = nil
+	}()
+
+	read := func(t *testing.T, backendType string) (cty.Value, tfdiags.Diagnostics) {
+		t.Helper()
+		schema := dataSourceRemoteStateGetSchema().Block
+		config, err := schema.CoerceValue(cty.ObjectVal(map[string]cty.Value{
+			"backend":           cty.StringVal(backendType),
+			"workspace_pattern": cty.StringVal("prod-*"),
+			"defaults": cty.ObjectVal(map[string]cty.Value{
+				"region": cty.StringVal("unknown"),
+			}),
+		}))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		diags := dataSourceRemoteStateValidate(config)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected validation errors: %s", diags.Err())
+		}
+		return dataSourceRemoteStateRead(config, encryption.StateEncryptionDisabled())
+	}
+
+	t.Run("matching workspaces", func(t *testing.T) {
+		got, diags := read(t, "workspaces")
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		if len(diags) != 1 || diags[0].Severity() != tfdiags.Warning {
+			t.Fatalf("expected one warning for the denied workspace, got %#v", diags)
+		}
+
+		wantOutputs := cty.ObjectVal(map[string]cty.Value{
+			"prod-eu": cty.ObjectVal(map[string]cty.Value{
+				"name":   cty.StringVal("prod-eu"),
+				"region": cty.StringVal("unknown"),
+			}),
+		})
+		if gotOutputs := got.GetAttr("workspace_outputs"); !wantOutputs.RawEquals(gotOutputs) {
+			t.Errorf("wrong workspace_outputs\ngot:  %swant: %s", dump.Value(gotOutputs), dump.Value(wantOutputs))
+		}
+		wantErrors := cty.MapVal(map[string]cty.Value{
+			"prod-us": cty.StringVal("error loading the remote state: access denied"),
+		})
+		if gotErrors := got.GetAttr("workspace_errors"); !wantErrors.RawEquals(gotErrors) {
+			t.Errorf("wrong workspace_errors\ngot:  %swant: %s", dump.Value(gotErrors), dump.Value(wantErrors))
+		}
+		if !got.GetAttr("outputs").IsNull() {
+			t.Errorf("outputs should be null when workspace_pattern is set, got %#v", got.GetAttr("outputs"))
+		}
+	})
+
+	t.Run("workspaces not supported", func(t *testing.T) {
+		_, diags := read(t, "noworkspaces")
+		if !diags.HasErrors() {
+			t.Fatal("succeeded; want error")
+		}
+		if got, want := diags.Err().Error(), "Backend does not support workspaces"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+		}
+	})
+}
+
+// backendWorkspaces is a backend with several in-memory workspaces, some of
+// which can be configured to fail to load as if access to them was denied.
+type backendWorkspaces struct {
+	states        map[string]*states.State
+	denied        map[string]bool
+	workspacesErr error
+}
+
+func (b backendWorkspaces) ConfigSchema() *configschema.Block {
+	return &configschema.Block{}
+}
+
+func (b backendWorkspaces) PrepareConfig(given cty.Value) (cty.Value, tfdiags.Diagnostics) {
+	return given, nil
+}
+
+func (b backendWorkspaces) Configure(config cty.Value) tfdiags.Diagnostics {
+	return nil
+}
+
+func (b backendWorkspaces) StateMgr(workspace string) (statemgr.Full, error) {
+	if b.denied[workspace] {
+		return nil, fmt.Errorf("access denied")
+	}
+	return statemgr.NewFullFake(nil, b.states[workspace]), nil
+}
+
+func (b backendWorkspaces) DeleteWorkspace(name string, _ bool) error {
+	return fmt.Errorf("DeleteWorkspace not implemented")
+}
+
+func (b backendWorkspaces) Workspaces() ([]string, error) {
+	if b.workspacesErr != nil {
+		return nil, b.workspacesErr
+	}
+	names := make([]string, 0, len(b.states))
+	for name := range b.states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 type backendFailsConfigure struct{}
 
 func (b backendFailsConfigure) ConfigSchema() *configschema.Block {
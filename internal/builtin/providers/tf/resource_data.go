@@ -20,10 +20,11 @@ func dataStoreResourceSchema() providers.Schema {
 	return providers.Schema{
 		Block: &configschema.Block{
 			Attributes: map[string]*configschema.Attribute{
-				"input":            {Type: cty.DynamicPseudoType, Optional: true},
-				"output":           {Type: cty.DynamicPseudoType, Computed: true},
-				"triggers_replace": {Type: cty.DynamicPseudoType, Optional: true},
-				"id":               {Type: cty.String, Computed: true},
+				"input":                      {Type: cty.DynamicPseudoType, Optional: true},
+				"output":                     {Type: cty.DynamicPseudoType, Computed: true},
+				"triggers_replace":           {Type: cty.DynamicPseudoType, Optional: true},
+				"preserve_output_on_replace": {Type: cty.Bool, Optional: true},
+				"id":                         {Type: cty.String, Computed: true},
 			},
 		},
 	}
@@ -80,11 +81,7 @@ func planDataStoreResourceChange(req providers.PlanResourceChangeRequest) (resp
 		planned["id"] = cty.UnknownVal(cty.String).RefineNotNull()
 
 		// Output type must always match the input, even when it's null.
-		if input.IsNull() {
-			planned["output"] = input
-		} else {
-			planned["output"] = cty.UnknownVal(input.Type())
-		}
+		planned["output"] = plannedNewOutput(req.ProposedNewState)
 
 		resp.PlannedState = cty.ObjectVal(planned)
 		return resp
@@ -96,11 +93,7 @@ func planDataStoreResourceChange(req providers.PlanResourceChangeRequest) (resp
 
 		// We need to check the input for the replacement instance to compute a
 		// new output.
-		if input.IsNull() {
-			planned["output"] = input
-		} else {
-			planned["output"] = cty.UnknownVal(input.Type())
-		}
+		planned["output"] = plannedNewOutput(req.ProposedNewState)
 
 	case !req.PriorState.GetAttr("input").RawEquals(input):
 		// only input changed, so we only need to re-compute output
@@ -111,6 +104,27 @@ func planDataStoreResourceChange(req providers.PlanResourceChangeRequest) (resp
 	return resp
 }
 
+// plannedNewOutput returns the output to plan for a new object, whether it's
+// the first one or a replacement.
+//
+// Core plans a replacement without its prior state, both when planning and
+// again when applying, so the output can't depend on the prior object. Since
+// the output of a new object is always its input, we can instead plan it as
+// the input itself when preserve_output_on_replace is set and the input is
+// known, which gives the replacement the same output as the prior object
+// whenever the input is unchanged.
+func plannedNewOutput(proposed cty.Value) cty.Value {
+	input := proposed.GetAttr("input")
+	switch {
+	case input.IsNull():
+		return input
+	case proposed.GetAttr("preserve_output_on_replace").RawEquals(cty.True) && input.IsWhollyKnown():
+		return input
+	default:
+		return cty.UnknownVal(input.Type())
+	}
+}
+
 var testUUIDHook func() string
 
 func applyDataStoreResourceChange(req providers.ApplyResourceChangeRequest) (resp providers.ApplyResourceChangeResponse) {
@@ -16,10 +16,11 @@ import (
 
 func TestManagedDataValidate(t *testing.T) {
 	cfg := map[string]cty.Value{
-		"input":            cty.NullVal(cty.DynamicPseudoType),
-		"output":           cty.NullVal(cty.DynamicPseudoType),
-		"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-		"id":               cty.NullVal(cty.String),
+		"input":                      cty.NullVal(cty.DynamicPseudoType),
+		"output":                     cty.NullVal(cty.DynamicPseudoType),
+		"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+		"preserve_output_on_replace": cty.NullVal(cty.Bool),
+		"id":                         cty.NullVal(cty.String),
 	}
 
 	// empty
@@ -58,7 +59,8 @@ func TestManagedDataUpgradeState(t *testing.T) {
 		"triggers_replace": cty.ListVal([]cty.Value{
 			cty.StringVal("a"), cty.StringVal("b"),
 		}),
-		"id": cty.StringVal("not-quite-unique"),
+		"preserve_output_on_replace": cty.NullVal(cty.Bool),
+		"id":                         cty.StringVal("not-quite-unique"),
 	})
 
 	jsState, err := ctyjson.Marshal(state, ty)
@@ -82,6 +84,31 @@ func TestManagedDataUpgradeState(t *testing.T) {
 	}
 }
 
+func TestManagedDataUpgradeState_noPreserveOutput(t *testing.T) {
+	// State stored before the preserve_output_on_replace argument was added
+	// doesn't include it at all.
+	req := providers.UpgradeResourceStateRequest{
+		TypeName:     "terraform_data",
+		RawStateJSON: []byte(`{"input":{"value":"input","type":"string"},"output":{"value":"input","type":"string"},"triggers_replace":null,"id":"not-quite-unique"}`),
+	}
+
+	resp := upgradeDataStoreResourceState(req)
+	if resp.Diagnostics.HasErrors() {
+		t.Fatal("upgrade state error:", resp.Diagnostics.ErrWithWarnings())
+	}
+
+	want := cty.ObjectVal(map[string]cty.Value{
+		"input":                      cty.StringVal("input"),
+		"output":                     cty.StringVal("input"),
+		"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+		"preserve_output_on_replace": cty.NullVal(cty.Bool),
+		"id":                         cty.StringVal("not-quite-unique"),
+	})
+	if !resp.UpgradedState.RawEquals(want) {
+		t.Errorf("wrong upgraded state\ngot:  %#v\nwant: %#v", resp.UpgradedState, want)
+	}
+}
+
 func TestManagedDataRead(t *testing.T) {
 	req := providers.ReadResourceRequest{
 		TypeName: "terraform_data",
@@ -91,7 +118,8 @@ func TestManagedDataRead(t *testing.T) {
 			"triggers_replace": cty.ListVal([]cty.Value{
 				cty.StringVal("a"), cty.StringVal("b"),
 			}),
-			"id": cty.StringVal("not-quite-unique"),
+			"preserve_output_on_replace": cty.NullVal(cty.Bool),
+			"id":                         cty.StringVal("not-quite-unique"),
 		}),
 	}
 
@@ -117,90 +145,102 @@ func TestManagedDataPlan(t *testing.T) {
 		"create": {
 			prior: cty.NullVal(ty),
 			proposed: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.DynamicPseudoType),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.NullVal(cty.String),
+				"input":                      cty.NullVal(cty.DynamicPseudoType),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.NullVal(cty.String),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.DynamicPseudoType),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.UnknownVal(cty.String).RefineNotNull(),
+				"input":                      cty.NullVal(cty.DynamicPseudoType),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
 			}),
 		},
 
 		"create-typed-null-input": {
 			prior: cty.NullVal(ty),
 			proposed: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.String),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.NullVal(cty.String),
+				"input":                      cty.NullVal(cty.String),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.NullVal(cty.String),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.String),
-				"output":           cty.NullVal(cty.String),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.UnknownVal(cty.String).RefineNotNull(),
+				"input":                      cty.NullVal(cty.String),
+				"output":                     cty.NullVal(cty.String),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
 			}),
 		},
 
 		"create-output": {
 			prior: cty.NullVal(ty),
 			proposed: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.NullVal(cty.String),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.NullVal(cty.String),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.UnknownVal(cty.String),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.UnknownVal(cty.String).RefineNotNull(),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.UnknownVal(cty.String),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
 			}),
 		},
 
 		"update-input": {
 			prior: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			proposed: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.UnknownVal(cty.List(cty.String)),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.UnknownVal(cty.List(cty.String)),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.UnknownVal(cty.List(cty.String)),
-				"output":           cty.UnknownVal(cty.List(cty.String)),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.UnknownVal(cty.List(cty.String)),
+				"output":                     cty.UnknownVal(cty.List(cty.String)),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 
 		"update-trigger": {
 			prior: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			proposed: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.StringVal("new-value"),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.UnknownVal(cty.String),
-				"triggers_replace": cty.StringVal("new-value"),
-				"id":               cty.UnknownVal(cty.String).RefineNotNull(),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.UnknownVal(cty.String),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
 			}),
 		},
 
@@ -211,7 +251,8 @@ func TestManagedDataPlan(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("value"),
 				}),
-				"id": cty.StringVal("not-quite-unique"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			proposed: cty.ObjectVal(map[string]cty.Value{
 				"input":  cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
@@ -219,7 +260,8 @@ func TestManagedDataPlan(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("new value"),
 				}),
-				"id": cty.StringVal("not-quite-unique"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
 				"input":  cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
@@ -227,7 +269,135 @@ func TestManagedDataPlan(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("new value"),
 				}),
-				"id": cty.UnknownVal(cty.String).RefineNotNull(),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"create-preserve-output": {
+			prior: cty.NullVal(ty),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.NullVal(cty.String),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"update-trigger-preserve-output": {
+			prior: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"replace-preserve-output": {
+			// core plans the replacement object without the prior state
+			prior: cty.NullVal(ty),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.NullVal(cty.String),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"replace-preserve-output-input-changed": {
+			prior: cty.NullVal(ty),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("new-input"),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.NullVal(cty.String),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("new-input"),
+				"output":                     cty.StringVal("new-input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"update-input-trigger-preserve-output": {
+			prior: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("new-input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("new-input"),
+				"output":                     cty.StringVal("new-input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
+			}),
+		},
+
+		"update-trigger-preserve-output-unknown-input": {
+			prior: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			proposed: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.UnknownVal(cty.String),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.UnknownVal(cty.String),
+				"output":                     cty.UnknownVal(cty.String),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String).RefineNotNull(),
 			}),
 		},
 	} {
@@ -269,74 +439,84 @@ func TestManagedDataApply(t *testing.T) {
 		"create": {
 			prior: cty.NullVal(ty),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.DynamicPseudoType),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.UnknownVal(cty.String),
+				"input":                      cty.NullVal(cty.DynamicPseudoType),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String),
 			}),
 			state: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.NullVal(cty.DynamicPseudoType),
-				"output":           cty.NullVal(cty.DynamicPseudoType),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.NullVal(cty.DynamicPseudoType),
+				"output":                     cty.NullVal(cty.DynamicPseudoType),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 
 		"create-output": {
 			prior: cty.NullVal(ty),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.UnknownVal(cty.String),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.UnknownVal(cty.String),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.UnknownVal(cty.String),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String),
 			}),
 			state: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 
 		"update-input": {
 			prior: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
-				"output":           cty.UnknownVal(cty.List(cty.String)),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
+				"output":                     cty.UnknownVal(cty.List(cty.String)),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			state: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
-				"output":           cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
+				"output":                     cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 
 		"update-trigger": {
 			prior: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.NullVal(cty.DynamicPseudoType),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.NullVal(cty.DynamicPseudoType),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.UnknownVal(cty.String),
-				"triggers_replace": cty.StringVal("new-value"),
-				"id":               cty.UnknownVal(cty.String),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.UnknownVal(cty.String),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String),
 			}),
 			state: cty.ObjectVal(map[string]cty.Value{
-				"input":            cty.StringVal("input"),
-				"output":           cty.StringVal("input"),
-				"triggers_replace": cty.StringVal("new-value"),
-				"id":               cty.StringVal("not-quite-unique"),
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 
@@ -347,7 +527,8 @@ func TestManagedDataApply(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("value"),
 				}),
-				"id": cty.StringVal("not-quite-unique"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 			planned: cty.ObjectVal(map[string]cty.Value{
 				"input":  cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
@@ -355,7 +536,8 @@ func TestManagedDataApply(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("new value"),
 				}),
-				"id": cty.UnknownVal(cty.String),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.UnknownVal(cty.String),
 			}),
 			state: cty.ObjectVal(map[string]cty.Value{
 				"input":  cty.ListVal([]cty.Value{cty.StringVal("new-input")}),
@@ -363,7 +545,32 @@ func TestManagedDataApply(t *testing.T) {
 				"triggers_replace": cty.MapVal(map[string]cty.Value{
 					"key": cty.StringVal("new value"),
 				}),
-				"id": cty.StringVal("not-quite-unique"),
+				"preserve_output_on_replace": cty.NullVal(cty.Bool),
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+		},
+
+		"update-trigger-preserve-output": {
+			prior: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
+			}),
+			planned: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.UnknownVal(cty.String),
+			}),
+			state: cty.ObjectVal(map[string]cty.Value{
+				"input":                      cty.StringVal("input"),
+				"output":                     cty.StringVal("input"),
+				"triggers_replace":           cty.StringVal("new-value"),
+				"preserve_output_on_replace": cty.True,
+				"id":                         cty.StringVal("not-quite-unique"),
 			}),
 		},
 	} {
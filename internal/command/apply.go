@@ -53,7 +53,8 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 
 	// Instantiate the view, even if there are flag errors, so that we render
 	// diagnostics according to the desired view
-	view := views.NewApply(args.ViewType, c.Destroy, c.View)
+	view := views.NewApply(args.ViewType, c.Destroy, args.ConsolidateWarnings, c.View)
+	defer view.WarningSummary()
 
 	if diags.HasErrors() {
 		view.Diagnostics(diags)
@@ -110,6 +111,8 @@ func (c *ApplyCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.refreshParallelism = args.Operation.RefreshParallelism
+	c.Meta.noSchemaCache = args.Operation.NoSchemaCache
 
 	// Prepare the backend, passing the plan file if present, and the
 	// backend-specific arguments
@@ -285,6 +288,7 @@ func (c *ApplyCommand) OperationRequest(
 	opReq.PlanRefresh = args.Refresh
 	opReq.Targets = args.Targets
 	opReq.ForceReplace = args.ForceReplace
+	opReq.ForceReplaceModules = args.ForceReplaceModules
 	opReq.Type = backend.OperationTypeApply
 	opReq.View = view.Operation()
 
@@ -356,6 +360,12 @@ Options:
                          accompanied by errors, show them in a more compact
                          form that includes only the summary messages.
 
+  -consolidate-warnings  Don't show warnings as they occur. Instead, show
+                         each distinct warning once at the end, with the
+                         number of times it occurred. Errors are still shown
+                         as they occur. With -compact-warnings, only the
+                         summary messages are shown.
+
   -destroy               Destroy OpenTofu-managed infrastructure.
                          The command "tofu destroy" is a convenience alias
                          for this option.
@@ -368,10 +378,22 @@ Options:
 
   -input=true            Ask for input for variables if not directly set.
 
+  -concise               Displays plan output in a concise way, skipping the
+                         refreshing log lines and showing only the attributes
+                         that change.
+
   -no-color              If specified, output won't contain any color.
 
+  -no-schema-cache       Don't use the on-disk provider schema cache, even if
+                         it's enabled in the CLI configuration.
+
   -parallelism=n         Limit the number of parallel resource operations.
-                         Defaults to 10.
+                         Defaults to 10. Set to 0 to use the number of CPUs,
+                         up to 32.
+
+  -refresh-parallelism=n Limit the number of parallel operations when
+                         planning with -refresh-only, which only reads from
+                         providers. Defaults to the -parallelism value.
 
   -state=path            Path to read and save state (unless state-out
                          is specified). Defaults to "terraform.tfstate".
@@ -382,6 +404,10 @@ Options:
 
   -show-sensitive        If specified, sensitive values will be displayed.
 
+  -target-file=path      Limit the operation to the resource addresses listed
+                         in the given file, one per line, as with -target.
+                         Blank lines and lines starting with "#" are ignored.
+
   If you don't provide a saved plan file then this command will also accept
   all of the plan-customization options accepted by the tofu plan command.
   For more information on those options, run:
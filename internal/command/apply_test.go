@@ -838,6 +838,8 @@ func TestApply_plan_remoteState(t *testing.T) {
 		"client_certificate_pem":    cty.NullVal(cty.String),
 		"client_private_key_pem":    cty.NullVal(cty.String),
 		"headers":                   cty.NullVal(cty.String),
+		"hmac_secret":               cty.NullVal(cty.String),
+		"signature_header":          cty.NullVal(cty.String),
 	})
 	backendConfigRaw, err := plans.NewDynamicValue(backendConfig, backendConfig.Type())
 	if err != nil {
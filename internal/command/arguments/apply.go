@@ -34,6 +34,10 @@ type Apply struct {
 
 	// ShowSensitive is used to display the value of variables marked as sensitive.
 	ShowSensitive bool
+
+	// ConsolidateWarnings holds back warnings in the human view until the
+	// operation has finished, and then shows each distinct warning once.
+	ConsolidateWarnings bool
 }
 
 // ParseApply processes CLI arguments, returning an Apply value and errors.
@@ -51,6 +55,7 @@ func ParseApply(args []string) (*Apply, tfdiags.Diagnostics) {
 	cmdFlags.BoolVar(&apply.AutoApprove, "auto-approve", false, "auto-approve")
 	cmdFlags.BoolVar(&apply.InputEnabled, "input", true, "input")
 	cmdFlags.BoolVar(&apply.ShowSensitive, "show-sensitive", false, "displays sensitive values")
+	cmdFlags.BoolVar(&apply.ConsolidateWarnings, "consolidate-warnings", false, "consolidate-warnings")
 
 	var json bool
 	cmdFlags.BoolVar(&json, "json", false, "json")
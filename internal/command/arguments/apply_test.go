@@ -84,6 +84,21 @@ func TestParseApply_basicValid(t *testing.T) {
 				},
 			},
 		},
+		"consolidated warnings": {
+			[]string{"-consolidate-warnings"},
+			&Apply{
+				InputEnabled:        true,
+				ViewType:            ViewHuman,
+				ConsolidateWarnings: true,
+				State:               &State{Lock: true},
+				Vars:                &Vars{},
+				Operation: &Operation{
+					PlanMode:    plans.NormalMode,
+					Parallelism: 10,
+					Refresh:     true,
+				},
+			},
+		},
 	}
 
 	cmpOpts := cmpopts.IgnoreUnexported(Operation{}, Vars{}, State{})
@@ -219,10 +234,13 @@ func TestParseApply_targets(t *testing.T) {
 func TestParseApply_replace(t *testing.T) {
 	foobarbaz, _ := addrs.ParseAbsResourceInstanceStr("foo_bar.baz")
 	foobarbeep, _ := addrs.ParseAbsResourceInstanceStr("foo_bar.beep")
+	boop, _ := addrs.ParseModuleInstanceStr("module.boop")
+	boopA, _ := addrs.ParseModuleInstanceStr(`module.boop["a"].module.child`)
 	testCases := map[string]struct {
-		args    []string
-		want    []addrs.AbsResourceInstance
-		wantErr string
+		args        []string
+		want        []addrs.AbsResourceInstance
+		wantModules []addrs.ModuleInstance
+		wantErr     string
 	}{
 		"no addresses by default": {
 			args: nil,
@@ -236,10 +254,20 @@ func TestParseApply_replace(t *testing.T) {
 			args: []string{"-replace=foo_bar.baz", "-replace", "foo_bar.beep"},
 			want: []addrs.AbsResourceInstance{foobarbaz, foobarbeep},
 		},
+		"module address": {
+			args:        []string{"-replace=module.boop"},
+			want:        nil,
+			wantModules: []addrs.ModuleInstance{boop},
+		},
+		"module instance address": {
+			args:        []string{`-replace=module.boop["a"].module.child`, "-replace=foo_bar.baz"},
+			want:        []addrs.AbsResourceInstance{foobarbaz},
+			wantModules: []addrs.ModuleInstance{boopA},
+		},
 		"non-resource-instance address": {
-			args:    []string{"-replace=module.boop"},
+			args:    []string{"-replace=module.boop.foo"},
 			want:    nil,
-			wantErr: "A resource instance address is required here.",
+			wantErr: "Resource specification must include a resource type and name.",
 		},
 		"data resource address": {
 			args:    []string{"-replace=data.foo.bar"},
@@ -271,6 +299,9 @@ func TestParseApply_replace(t *testing.T) {
 			if !cmp.Equal(got.Operation.ForceReplace, tc.want) {
 				t.Fatalf("unexpected result\n%s", cmp.Diff(got.Operation.Targets, tc.want))
 			}
+			if !cmp.Equal(got.Operation.ForceReplaceModules, tc.wantModules) {
+				t.Fatalf("unexpected modules\n%s", cmp.Diff(got.Operation.ForceReplaceModules, tc.wantModules))
+			}
 		})
 	}
 }
@@ -6,8 +6,14 @@
 package arguments
 
 import (
+	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/hcl/v2"
@@ -22,6 +28,30 @@ import (
 // operations as it walks the dependency graph.
 const DefaultParallelism = 10
 
+// MaxAutoParallelism is the largest limit that -parallelism=0 resolves to,
+// so that a machine with many CPUs doesn't overwhelm providers with
+// concurrent requests.
+const MaxAutoParallelism = 32
+
+// ResolveParallelism checks the value of the -parallelism option, and
+// resolves zero to the number of CPUs, up to MaxAutoParallelism.
+func ResolveParallelism(parallelism int) (int, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	switch {
+	case parallelism < 0:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid parallelism value",
+			fmt.Sprintf("The -parallelism option must be zero or a positive number, not %d.", parallelism),
+		))
+	case parallelism == 0:
+		parallelism = min(runtime.NumCPU(), MaxAutoParallelism)
+		log.Printf("[INFO] Using parallelism of %d based on the number of CPUs", parallelism)
+	}
+	return parallelism, diags
+}
+
 // State describes arguments which are used to define how OpenTofu interacts
 // with state.
 type State struct {
@@ -58,9 +88,19 @@ type Operation struct {
 	PlanMode plans.Mode
 
 	// Parallelism is the limit OpenTofu places on total parallel operations
-	// as it walks the dependency graph.
+	// as it walks the dependency graph. Parse resolves a value of zero to
+	// the number of CPUs, using ResolveParallelism.
 	Parallelism int
 
+	// RefreshParallelism is the limit OpenTofu places on total parallel
+	// operations for walks that only read from providers, such as a
+	// refresh-only plan. Zero means to use the same limit as Parallelism.
+	RefreshParallelism int
+
+	// NoSchemaCache disables the on-disk provider schema cache for this
+	// operation, if it's enabled in the CLI configuration.
+	NoSchemaCache bool
+
 	// Refresh controls whether or not the operation should refresh existing
 	// state before proceeding. Default is true.
 	Refresh bool
@@ -72,19 +112,20 @@ type Operation struct {
 	// ForceReplace addresses cause OpenTofu to force a particular set of
 	// resource instances to generate "replace" actions in any plan where they
 	// would normally have generated "no-op" or "update" actions.
-	//
-	// This is currently limited to specific instances because typical uses
-	// of replace are associated with only specific remote objects that the
-	// user has somehow learned to be malfunctioning, in which case it
-	// would be unusual and potentially dangerous to replace everything under
-	// a module all at once. We could potentially loosen this later if we
-	// learn a use-case for broader matching.
 	ForceReplace []addrs.AbsResourceInstance
 
+	// ForceReplaceModules are module instances given to -replace, all of
+	// whose managed resource instances, including those in nested modules,
+	// are to be force-replaced as for ForceReplace. This is for situations
+	// such as a breaking change in a provider, where everything in a module
+	// needs recreating; replacing specific objects remains the typical use.
+	ForceReplaceModules []addrs.ModuleInstance
+
 	// These private fields are used only temporarily during decoding. Use
 	// method Parse to populate the exported fields from these, validating
 	// the raw values in the process.
 	targetsRaw      []string
+	targetFilesRaw  []string
 	forceReplaceRaw []string
 	destroyRaw      bool
 	refreshOnlyRaw  bool
@@ -98,6 +139,10 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 
 	o.Targets = nil
 
+	parallelism, parallelismDiags := ResolveParallelism(o.Parallelism)
+	diags = diags.Append(parallelismDiags)
+	o.Parallelism = parallelism
+
 	for _, tr := range o.targetsRaw {
 		traversal, syntaxDiags := hclsyntax.ParseTraversalAbs([]byte(tr), "", hcl.Pos{Line: 1, Column: 1})
 		if syntaxDiags.HasErrors() {
@@ -122,6 +167,12 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 		o.Targets = append(o.Targets, target.Subject)
 	}
 
+	for _, path := range o.targetFilesRaw {
+		targets, fileDiags := parseTargetFile(path)
+		diags = diags.Append(fileDiags)
+		o.Targets = append(o.Targets, targets...)
+	}
+
 	for _, raw := range o.forceReplaceRaw {
 		traversal, syntaxDiags := hclsyntax.ParseTraversalAbs([]byte(raw), "", hcl.Pos{Line: 1, Column: 1})
 		if syntaxDiags.HasErrors() {
@@ -135,6 +186,12 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 
 		addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
 		if addrDiags.HasErrors() {
+			// The address might instead be a module instance, meaning
+			// everything inside it.
+			if module, moduleDiags := addrs.ParseModuleInstance(traversal); !moduleDiags.HasErrors() && !module.IsRoot() {
+				o.ForceReplaceModules = append(o.ForceReplaceModules, module)
+				continue
+			}
 			diags = diags.Append(tfdiags.Sourceless(
 				tfdiags.Error,
 				fmt.Sprintf("Invalid force-replace address %q", raw),
@@ -182,6 +239,76 @@ func (o *Operation) Parse() tfdiags.Diagnostics {
 	return diags
 }
 
+// parseTargetFile reads target addresses from the file at the given path,
+// which must contain one address per line. Blank lines and lines starting
+// with "#" are ignored.
+func parseTargetFile(path string) ([]addrs.Targetable, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	var targets []addrs.Targetable
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read target file",
+			fmt.Sprintf("Couldn't read the target addresses from %s: %s.", path, err),
+		))
+		return nil, diags
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(src))
+	line := 0
+	for sc.Scan() {
+		line++
+		raw := sc.Text()
+		tr := strings.TrimSpace(raw)
+		if tr == "" || strings.HasPrefix(tr, "#") {
+			continue
+		}
+
+		column := strings.Index(raw, tr) + 1
+		start := hcl.Pos{Line: line, Column: column}
+		subject := &hcl.Range{
+			Filename: path,
+			Start:    start,
+			End:      hcl.Pos{Line: line, Column: column + len(tr)},
+		}
+
+		traversal, syntaxDiags := hclsyntax.ParseTraversalAbs([]byte(tr), path, start)
+		if syntaxDiags.HasErrors() {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  fmt.Sprintf("Invalid target %q", tr),
+				Detail:   syntaxDiags[0].Detail,
+				Subject:  subject,
+			})
+			continue
+		}
+
+		target, targetDiags := addrs.ParseTarget(traversal)
+		if targetDiags.HasErrors() {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  fmt.Sprintf("Invalid target %q", tr),
+				Detail:   targetDiags[0].Description().Detail,
+				Subject:  subject,
+			})
+			continue
+		}
+
+		targets = append(targets, target.Subject)
+	}
+	if err := sc.Err(); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read target file",
+			fmt.Sprintf("Couldn't read the target addresses from %s: %s.", path, err),
+		))
+	}
+
+	return targets, diags
+}
+
 // Vars describes arguments which specify non-default variable values. This
 // interface is unfortunately obscure, because the order of the CLI arguments
 // determines the final value of the gathered variables. In future it might be
@@ -226,10 +353,13 @@ func extendedFlagSet(name string, state *State, operation *Operation, vars *Vars
 
 	if operation != nil {
 		f.IntVar(&operation.Parallelism, "parallelism", DefaultParallelism, "parallelism")
+		f.IntVar(&operation.RefreshParallelism, "refresh-parallelism", 0, "refresh-parallelism")
+		f.BoolVar(&operation.NoSchemaCache, "no-schema-cache", false, "no-schema-cache")
 		f.BoolVar(&operation.Refresh, "refresh", true, "refresh")
 		f.BoolVar(&operation.destroyRaw, "destroy", false, "destroy")
 		f.BoolVar(&operation.refreshOnlyRaw, "refresh-only", false, "refresh-only")
 		f.Var((*flagStringSlice)(&operation.targetsRaw), "target", "target")
+		f.Var((*flagStringSlice)(&operation.targetFilesRaw), "target-file", "target-file")
 		f.Var((*flagStringSlice)(&operation.forceReplaceRaw), "replace", "replace")
 	}
 
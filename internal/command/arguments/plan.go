@@ -20,6 +20,10 @@ type Plan struct {
 	// changes, and success with no changes.
 	DetailedExitCode bool
 
+	// ExpectNoChanges makes the command fail with a list of the planned
+	// changes, and a dedicated exit code, when the plan isn't empty.
+	ExpectNoChanges bool
+
 	// InputEnabled is used to disable interactive input for unspecified
 	// variable and backend config values. Default is true.
 	InputEnabled bool
@@ -52,6 +56,7 @@ func ParsePlan(args []string) (*Plan, tfdiags.Diagnostics) {
 
 	cmdFlags := extendedFlagSet("plan", plan.State, plan.Operation, plan.Vars)
 	cmdFlags.BoolVar(&plan.DetailedExitCode, "detailed-exitcode", false, "detailed-exitcode")
+	cmdFlags.BoolVar(&plan.ExpectNoChanges, "expect-no-changes", false, "expect-no-changes")
 	cmdFlags.BoolVar(&plan.InputEnabled, "input", true, "input")
 	cmdFlags.StringVar(&plan.OutPath, "out", "", "out")
 	cmdFlags.StringVar(&plan.GenerateConfigPath, "generate-config-out", "", "generate-config-out")
@@ -6,6 +6,9 @@
 package arguments
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -37,9 +40,10 @@ func TestParsePlan_basicValid(t *testing.T) {
 			},
 		},
 		"setting all options": {
-			[]string{"-destroy", "-detailed-exitcode", "-input=false", "-out=saved.tfplan"},
+			[]string{"-destroy", "-detailed-exitcode", "-expect-no-changes", "-input=false", "-out=saved.tfplan"},
 			&Plan{
 				DetailedExitCode: true,
+				ExpectNoChanges:  true,
 				InputEnabled:     false,
 				OutPath:          "saved.tfplan",
 				ViewType:         ViewHuman,
@@ -52,6 +56,23 @@ func TestParsePlan_basicValid(t *testing.T) {
 				},
 			},
 		},
+		"refresh parallelism": {
+			[]string{"-refresh-parallelism=25"},
+			&Plan{
+				DetailedExitCode: false,
+				InputEnabled:     true,
+				OutPath:          "",
+				ViewType:         ViewHuman,
+				State:            &State{Lock: true},
+				Vars:             &Vars{},
+				Operation: &Operation{
+					PlanMode:           plans.NormalMode,
+					Parallelism:        10,
+					RefreshParallelism: 25,
+					Refresh:            true,
+				},
+			},
+		},
 		"JSON view disables input": {
 			[]string{"-json"},
 			&Plan{
@@ -111,6 +132,54 @@ func TestParsePlan_tooManyArguments(t *testing.T) {
 	}
 }
 
+func TestParsePlan_parallelism(t *testing.T) {
+	testCases := map[string]struct {
+		args    []string
+		want    int
+		wantErr string
+	}{
+		"default": {
+			nil,
+			DefaultParallelism,
+			"",
+		},
+		"explicit": {
+			[]string{"-parallelism=100"},
+			100,
+			"",
+		},
+		"zero uses the CPU count": {
+			[]string{"-parallelism=0"},
+			min(runtime.NumCPU(), MaxAutoParallelism),
+			"",
+		},
+		"negative": {
+			[]string{"-parallelism=-1"},
+			-1,
+			"The -parallelism option must be zero or a positive number, not -1.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := ParsePlan(tc.args)
+			if tc.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatal("expected diags but got none")
+				}
+				if got := diags.Err().Error(); !strings.Contains(got, tc.wantErr) {
+					t.Fatalf("wrong diags\n got: %s\nwant: %s", got, tc.wantErr)
+				}
+			} else if len(diags) > 0 {
+				t.Fatalf("unexpected diags: %v", diags)
+			}
+			if got.Operation.Parallelism != tc.want {
+				t.Fatalf("wrong parallelism %d; want %d", got.Operation.Parallelism, tc.want)
+			}
+		})
+	}
+}
+
 func TestParsePlan_targets(t *testing.T) {
 	foobarbaz, _ := addrs.ParseTargetStr("foo_bar.baz")
 	boop, _ := addrs.ParseTargetStr("module.boop")
@@ -160,6 +229,78 @@ func TestParsePlan_targets(t *testing.T) {
 	}
 }
 
+func TestParsePlan_targetFile(t *testing.T) {
+	foobarbaz, _ := addrs.ParseTargetStr("foo_bar.baz")
+	boop, _ := addrs.ParseTargetStr("module.boop")
+	beep, _ := addrs.ParseTargetStr("foo_bar.beep[1]")
+
+	dir := t.TempDir()
+	validPath := filepath.Join(dir, "valid.txt")
+	if err := os.WriteFile(validPath, []byte("# targets\nmodule.boop\n\n  foo_bar.beep[1]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalidPath := filepath.Join(dir, "invalid.txt")
+	if err := os.WriteFile(invalidPath, []byte("module.boop\ndata[0].foo\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		args    []string
+		want    []addrs.Targetable
+		wantErr string
+	}{
+		"targets from file": {
+			args: []string{"-target-file=" + validPath},
+			want: []addrs.Targetable{boop.Subject, beep.Subject},
+		},
+		"merged with inline targets": {
+			args: []string{"-target-file", validPath, "-target=foo_bar.baz"},
+			want: []addrs.Targetable{foobarbaz.Subject, boop.Subject, beep.Subject},
+		},
+		"invalid target in file": {
+			args:    []string{"-target-file=" + invalidPath},
+			want:    []addrs.Targetable{boop.Subject},
+			wantErr: "A data source name is required",
+		},
+		"missing file": {
+			args:    []string{"-target-file=" + filepath.Join(dir, "missing.txt")},
+			want:    nil,
+			wantErr: "Failed to read target file",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := ParsePlan(tc.args)
+			if len(diags) > 0 {
+				if tc.wantErr == "" {
+					t.Fatalf("unexpected diags: %v", diags)
+				} else if got := diags.Err().Error(); !strings.Contains(got, tc.wantErr) {
+					t.Fatalf("wrong diags\n got: %s\nwant: %s", got, tc.wantErr)
+				}
+			} else if tc.wantErr != "" {
+				t.Fatalf("no diags; want %q", tc.wantErr)
+			}
+			if !cmp.Equal(got.Operation.Targets, tc.want) {
+				t.Fatalf("unexpected result\n%s", cmp.Diff(got.Operation.Targets, tc.want))
+			}
+		})
+	}
+
+	// Diagnostics for invalid lines must point at the line in the file.
+	_, diags := ParsePlan([]string{"-target-file=" + invalidPath})
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+	}
+	subject := diags[0].Source().Subject
+	if subject == nil {
+		t.Fatal("diagnostic has no source location")
+	}
+	if subject.Filename != invalidPath || subject.Start.Line != 2 {
+		t.Fatalf("wrong source location %s:%d; want %s:2", subject.Filename, subject.Start.Line, invalidPath)
+	}
+}
+
 func TestParsePlan_vars(t *testing.T) {
 	testCases := map[string]struct {
 		args []string
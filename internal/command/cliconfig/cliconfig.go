@@ -31,6 +31,7 @@ import (
 
 const pluginCacheDirEnvVar = "TF_PLUGIN_CACHE_DIR"
 const pluginCacheMayBreakLockFileEnvVar = "TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"
+const providerSchemaCacheEnvVar = "TF_PROVIDER_SCHEMA_CACHE"
 
 // Config is the structure of the configuration for the OpenTofu CLI.
 //
@@ -55,6 +56,11 @@ type Config struct {
 	// over the requirements of the dependency lock file.
 	PluginCacheMayBreakDependencyLockFile bool `hcl:"plugin_cache_may_break_dependency_lock_file"`
 
+	// ProviderSchemaCache enables caching provider schemas on disk in the
+	// working directory's data directory, to avoid requesting the full schema
+	// from each provider on every run.
+	ProviderSchemaCache bool `hcl:"provider_schema_cache"`
+
 	Hosts map[string]*ConfigHost `hcl:"host"`
 
 	Credentials        map[string]map[string]interface{}   `hcl:"credentials"`
@@ -257,6 +263,10 @@ func envConfig(env map[string]string) *Config {
 		config.PluginCacheMayBreakDependencyLockFile = true
 	}
 
+	if envSchemaCache := env[providerSchemaCacheEnvVar]; envSchemaCache != "" && envSchemaCache != "0" {
+		config.ProviderSchemaCache = true
+	}
+
 	return config
 }
 
@@ -375,6 +385,10 @@ func (c *Config) Merge(c2 *Config) *Config {
 		result.PluginCacheMayBreakDependencyLockFile = true
 	}
 
+	if c.ProviderSchemaCache || c2.ProviderSchemaCache {
+		result.ProviderSchemaCache = true
+	}
+
 	if (len(c.Hosts) + len(c2.Hosts)) > 0 {
 		result.Hosts = make(map[string]*ConfigHost)
 		for name, host := range c.Hosts {
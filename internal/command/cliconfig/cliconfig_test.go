@@ -125,6 +125,20 @@ func TestEnvConfig(t *testing.T) {
 				PluginCacheMayBreakDependencyLockFile: true,
 			},
 		},
+		"TF_PROVIDER_SCHEMA_CACHE=1": {
+			map[string]string{
+				"TF_PROVIDER_SCHEMA_CACHE": "1",
+			},
+			&Config{
+				ProviderSchemaCache: true,
+			},
+		},
+		"TF_PROVIDER_SCHEMA_CACHE=0": {
+			map[string]string{
+				"TF_PROVIDER_SCHEMA_CACHE": "0",
+			},
+			&Config{},
+		},
 	}
 
 	for name, test := range tests {
@@ -14,6 +14,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/arguments"
+	"github.com/opentofu/opentofu/internal/plans/planfile"
 	"github.com/opentofu/opentofu/internal/repl"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -28,9 +29,12 @@ type ConsoleCommand struct {
 }
 
 func (c *ConsoleCommand) Run(args []string) int {
+	var planPath string
+
 	args = c.Meta.process(args)
 	cmdFlags := c.Meta.extendedFlagSet("console")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&planPath, "plan", "", "path")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command line flags: %s\n", err.Error()))
@@ -60,6 +64,23 @@ func (c *ConsoleCommand) Run(args []string) int {
 		return 1
 	}
 
+	var planFile *planfile.WrappedPlanFile
+	if planPath != "" {
+		planFile, err = c.PlanFile(planPath, enc.Plan())
+		if err == nil && planFile == nil {
+			err = fmt.Errorf("the path is a directory, not a plan file")
+		}
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				fmt.Sprintf("Failed to load %q as a plan file", planPath),
+				fmt.Sprintf("Error: %s", err),
+			))
+			c.showDiagnostics(diags)
+			return 1
+		}
+	}
+
 	backendConfig, backendDiags := c.loadBackendConfig(configPath)
 	diags = diags.Append(backendDiags)
 	if diags.HasErrors() {
@@ -91,6 +112,7 @@ func (c *ConsoleCommand) Run(args []string) int {
 	// Build the operation
 	opReq := c.Operation(b, arguments.ViewHuman, enc)
 	opReq.ConfigDir = configPath
+	opReq.PlanFile = planFile
 	opReq.ConfigLoader, err = c.initConfigLoader()
 	opReq.AllowUnsetVariables = true // we'll just evaluate them as unknown
 	if err != nil {
@@ -140,6 +162,9 @@ func (c *ConsoleCommand) Run(args []string) int {
 		// not actually making a plan.
 		evalOpts.SetVariables = lr.PlanOpts.SetVariables
 	}
+	// When given a saved plan, we evaluate against its planned values and
+	// the variable values recorded in it instead.
+	evalOpts.Plan = lr.Plan
 
 	// Before we can evaluate expressions, we must compute and populate any
 	// derived values (input variables, local values, output values)
@@ -167,7 +192,8 @@ func (c *ConsoleCommand) Run(args []string) int {
 
 	// IO Loop
 	session := &repl.Session{
-		Scope: scope,
+		Scope:             scope,
+		ProviderFunctions: lr.Core.ProviderFunctions(lr.Config),
 	}
 
 	// Determine if stdin is a pipe. If so, we evaluate directly.
@@ -223,6 +249,12 @@ Usage: tofu [global options] console [options]
 
 Options:
 
+  -plan=path        Evaluate expressions against the planned values in the
+                    given saved plan file instead of the current state.
+                    Values that won't be known until apply are shown as
+                    "(known after apply)". The configuration and variable
+                    values recorded in the plan are used.
+
   -state=path       Legacy option for the local backend only. See the local
                     backend's documentation for more information.
 
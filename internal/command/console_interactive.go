@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"unicode"
 
 	"github.com/opentofu/opentofu/internal/repl"
 
@@ -25,6 +26,7 @@ func (c *ConsoleCommand) modeInteractive(session *repl.Session, ui cli.Ui) int {
 		InterruptPrompt:   "^C",
 		EOFPrompt:         "exit",
 		HistorySearchFold: true,
+		AutoComplete:      &consoleFunctionCompleter{names: session.FunctionNames()},
 		Stdin:             os.Stdin,
 		Stdout:            os.Stdout,
 		Stderr:            os.Stderr,
@@ -81,3 +83,34 @@ func (c *ConsoleCommand) modeInteractive(session *repl.Session, ui cli.Ui) int {
 
 	return 0
 }
+
+// consoleFunctionCompleter completes the name of the function that is being
+// typed at the cursor position when the user presses the tab key.
+type consoleFunctionCompleter struct {
+	names []string
+}
+
+func (cc *consoleFunctionCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	start := pos
+	for start > 0 && isFunctionNameRune(line[start-1]) {
+		start--
+	}
+	if start == pos {
+		// With no function name to complete we insert the tab itself, as
+		// readline does by default, so indented input is kept as typed.
+		return [][]rune{[]rune("\t")}, 0
+	}
+	prefix := string(line[start:pos])
+
+	var suffixes [][]rune
+	for _, name := range cc.names {
+		if strings.HasPrefix(name, prefix) {
+			suffixes = append(suffixes, []rune(name[len(prefix):]))
+		}
+	}
+	return suffixes, pos - start
+}
+
+func isFunctionNameRune(r rune) bool {
+	return r == '_' || r == '-' || r == ':' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
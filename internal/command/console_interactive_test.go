@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/mitchellh/cli"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -119,3 +120,69 @@ func TestConsole_multiline_interactive(t *testing.T) {
 		})
 	}
 }
+
+func TestConsoleFunctionCompleter(t *testing.T) {
+	cc := &consoleFunctionCompleter{
+		names: []string{"abs", "base64decode", "base64encode", "provider::test::echo"},
+	}
+
+	tests := map[string]struct {
+		line       string
+		pos        int
+		want       []string
+		wantLength int
+	}{
+		"prefix": {
+			line:       "base64",
+			pos:        6,
+			want:       []string{"decode", "encode"},
+			wantLength: 6,
+		},
+		"inside expression": {
+			line:       "upper(ab",
+			pos:        8,
+			want:       []string{"s"},
+			wantLength: 2,
+		},
+		"provider function": {
+			line:       "provider::te",
+			pos:        12,
+			want:       []string{"st::echo"},
+			wantLength: 12,
+		},
+		"before cursor only": {
+			line:       "ab + 1",
+			pos:        2,
+			want:       []string{"s"},
+			wantLength: 2,
+		},
+		"no match": {
+			line:       "zzz",
+			pos:        3,
+			want:       nil,
+			wantLength: 3,
+		},
+		"nothing typed": {
+			line:       "1 + ",
+			pos:        4,
+			want:       []string{"\t"},
+			wantLength: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, length := cc.Do([]rune(test.line), test.pos)
+			var gotStrs []string
+			for _, r := range got {
+				gotStrs = append(gotStrs, string(r))
+			}
+			if diff := cmp.Diff(test.want, gotStrs); diff != "" {
+				t.Errorf("wrong completions\n%s", diff)
+			}
+			if length != test.wantLength {
+				t.Errorf("wrong length %d; want %d", length, test.wantLength)
+			}
+		})
+	}
+}
@@ -57,6 +57,108 @@ func TestConsole_basic(t *testing.T) {
 	}
 }
 
+func TestConsole_functions(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("console-functions"), td)
+	defer testChdir(t, td)()
+
+	p := testProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Functions: map[string]providers.FunctionSpec{
+			"echo": {
+				Summary: "Returns its argument",
+				Parameters: []providers.FunctionParameterSpec{
+					{Name: "input", Type: cty.String},
+				},
+				Return: cty.String,
+			},
+		},
+	}
+	ui := cli.NewMockUi()
+	view, _ := testView(t)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	var output bytes.Buffer
+	defer testStdinPipe(t, strings.NewReader("functions\n"))()
+	outCloser := testStdoutCapture(t, &output)
+
+	code := c.Run(nil)
+	outCloser()
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	got := output.String()
+	want := "\nFunctions of provider \"test\":\n  provider::test::echo(input string) string\n      Returns its argument\n"
+	if !strings.Contains(got, want) {
+		t.Fatalf("output does not contain %q\n%s", want, got)
+	}
+	if !strings.Contains(got, "Built-in functions:\n  abs(num number)\n") {
+		t.Fatalf("output does not list the built-in functions\n%s", got)
+	}
+}
+
+func TestConsole_plan(t *testing.T) {
+	testCwd(t)
+	planPath := applyFixturePlanFile(t)
+
+	p := applyFixtureProvider()
+	ui := cli.NewMockUi()
+	view, _ := testView(t)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	var output bytes.Buffer
+	defer testStdinPipe(t, strings.NewReader("test_instance.foo.ami\ntest_instance.foo.id\n"))()
+	outCloser := testStdoutCapture(t, &output)
+
+	args := []string{"-plan", planPath}
+	code := c.Run(args)
+	outCloser()
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := output.String()
+	if want := "\"bar\"\n(known after apply)\n"; actual != want {
+		t.Fatalf("wrong output\ngot:  %q\nwant: %q", actual, want)
+	}
+}
+
+func TestConsole_planNotFound(t *testing.T) {
+	testCwd(t)
+
+	p := testProvider()
+	ui := cli.NewMockUi()
+	view, _ := testView(t)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	args := []string{"-plan", "does-not-exist.tfplan"}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("wrong exit code %d; want 1", code)
+	}
+	if got, want := ui.ErrorWriter.String(), `Failed to load "does-not-exist.tfplan" as a plan file`; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 func TestConsole_tfvars(t *testing.T) {
 	td := t.TempDir()
 	testCopyDir(t, testFixturePath("apply-vars"), td)
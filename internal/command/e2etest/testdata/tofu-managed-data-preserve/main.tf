@@ -0,0 +1,13 @@
+variable "trigger" {
+  type = string
+}
+
+resource "terraform_data" "a" {
+  input                      = "foo"
+  triggers_replace           = var.trigger
+  preserve_output_on_replace = true
+}
+
+output "a" {
+  value = terraform_data.a.output
+}
@@ -58,3 +58,42 @@ func TestOpenTofuProviderData(t *testing.T) {
 		t.Fatalf("input %#v does not equal output %#v\n", input, output)
 	}
 }
+
+func TestOpenTofuProviderData_preserveOutputOnReplace(t *testing.T) {
+	fixturePath := filepath.Join("testdata", "tofu-managed-data-preserve")
+	tf := e2e.NewBinary(t, tofuBin, fixturePath)
+
+	_, stderr, err := tf.Run("init", "-input=false")
+	if err != nil {
+		t.Fatalf("unexpected init error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	_, stderr, err = tf.Run("apply", "-input=false", "-auto-approve", "-var=trigger=1")
+	if err != nil {
+		t.Fatalf("unexpected apply error: %s\nstderr:\n%s", err, stderr)
+	}
+
+	stdout, stderr, err := tf.Run("plan", "-out=tfplan", "-input=false", "-var=trigger=2")
+	if err != nil {
+		t.Fatalf("unexpected plan error: %s\nstderr:\n%s", err, stderr)
+	}
+	if !strings.Contains(stdout, "1 to add, 0 to change, 1 to destroy") {
+		t.Errorf("incorrect plan tally; want 1 to replace:\n%s", stdout)
+	}
+	for _, line := range strings.Split(stdout, "\n") {
+		if strings.Contains(line, "output") && strings.Contains(line, "known after apply") {
+			t.Errorf("output is not known in the plan:\n%s", stdout)
+			break
+		}
+	}
+
+	// The replacement is planned again while applying, which must produce
+	// the same known output as the saved plan.
+	stdout, stderr, err = tf.Run("apply", "-input=false", "tfplan")
+	if err != nil {
+		t.Fatalf("unexpected apply error: %s\nstderr:\n%s", err, stderr)
+	}
+	if !strings.Contains(stdout, "Resources: 1 added, 0 changed, 1 destroyed") {
+		t.Errorf("incorrect apply tally; want 1 replaced:\n%s", stdout)
+	}
+}
@@ -48,8 +48,20 @@ type FmtCommand struct {
 	check     bool
 	recursive bool
 	input     io.Reader // STDIN if nil
+
+	// unformatted is set when at least one of the processed inputs isn't
+	// in the canonical format.
+	unformatted bool
 }
 
+// Exit codes for the fmt command. These are a stable contract so that
+// scripts and pre-commit hooks can tell the outcomes of -check apart.
+const (
+	fmtExitOK          = 0
+	fmtExitError       = 1
+	fmtExitUnformatted = 2
+)
+
 func (c *FmtCommand) Run(args []string) int {
 	if c.input == nil {
 		c.input = os.Stdin
@@ -65,7 +77,7 @@ func (c *FmtCommand) Run(args []string) int {
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
-		return 1
+		return fmtExitError
 	}
 
 	args = cmdFlags.Args()
@@ -80,38 +92,21 @@ func (c *FmtCommand) Run(args []string) int {
 		paths = args
 	}
 
-	var output io.Writer
-	list := c.list // preserve the original value of -list
 	if c.check {
-		// set to true so we can use the list output to check
-		// if the input needs formatting
-		c.list = true
 		c.write = false
-		output = &bytes.Buffer{}
-	} else {
-		output = &cli.UiWriter{Ui: c.Ui}
 	}
 
-	diags := c.fmt(paths, c.input, output)
+	diags := c.fmt(paths, c.input, &cli.UiWriter{Ui: c.Ui})
 	c.showDiagnostics(diags)
 	if diags.HasErrors() {
-		return 2
+		return fmtExitError
 	}
 
-	if c.check {
-		buf := output.(*bytes.Buffer)
-		ok := buf.Len() == 0
-		if list {
-			io.Copy(&cli.UiWriter{Ui: c.Ui}, buf)
-		}
-		if ok {
-			return 0
-		} else {
-			return 3
-		}
+	if c.check && c.unformatted {
+		return fmtExitUnformatted
 	}
 
-	return 0
+	return fmtExitOK
 }
 
 func (c *FmtCommand) fmt(paths []string, stdin io.Reader, stdout io.Writer) tfdiags.Diagnostics {
@@ -199,6 +194,7 @@ func (c *FmtCommand) processFile(path string, r io.Reader, w io.Writer, isStdout
 
 	if !bytes.Equal(src, result) {
 		// Something was changed
+		c.unformatted = true
 		if c.list {
 			fmt.Fprintln(w, path)
 		}
@@ -219,7 +215,7 @@ func (c *FmtCommand) processFile(path string, r io.Reader, w io.Writer, isStdout
 		}
 	}
 
-	if !c.list && !c.write && !c.diff {
+	if !c.list && !c.write && !c.diff && !c.check {
 		_, err = w.Write(result)
 		if err != nil {
 			diags = diags.Append(fmt.Errorf("Failed to write result"))
@@ -576,13 +572,26 @@ Options:
 
   -diff          Display diffs of formatting changes
 
-  -check         Check if the input is formatted. Exit status will be 0 if all
-                 input is properly formatted and non-zero otherwise.
+  -check         Check if the input is formatted, without modifying it.
+                 See the exit status section below.
 
   -no-color      If specified, output won't contain any color.
 
   -recursive     Also process files in subdirectories. By default, only the
                  given directory (or current directory) is processed.
+
+Exit Status:
+
+  0  All input was processed successfully. With -check, all input is already
+     properly formatted.
+
+  1  An error occurred, such as a file that couldn't be read or written, or
+     that contains a syntax error. Files with syntax errors are never
+     reformatted.
+
+  2  Only with -check: all input was valid, but at least one file isn't
+     properly formatted. The files are listed unless -list=false is given,
+     and their diffs are shown if -diff is given.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -159,7 +159,7 @@ func TestFmt_nonexist(t *testing.T) {
 
 	missingDir := filepath.Join(tempDir, "doesnotexist")
 	args := []string{missingDir}
-	if code := c.Run(args); code != 2 {
+	if code := c.Run(args); code != 1 {
 		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
 	}
 
@@ -190,7 +190,7 @@ a = 1 +
 	}
 
 	args := []string{tempDir}
-	if code := c.Run(args); code != 2 {
+	if code := c.Run(args); code != 1 {
 		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
 	}
 
@@ -219,7 +219,7 @@ func TestFmt_snippetInError(t *testing.T) {
 	}
 
 	args := []string{tempDir}
-	if code := c.Run(args); code != 2 {
+	if code := c.Run(args); code != 1 {
 		t.Fatalf("wrong exit code. errors: \n%s", ui.ErrorWriter.String())
 	}
 
@@ -438,8 +438,8 @@ func TestFmt_check(t *testing.T) {
 		"-check",
 		tempDir,
 	}
-	if code := c.Run(args); code != 3 {
-		t.Fatalf("wrong exit code. expected 3")
+	if code := c.Run(args); code != 2 {
+		t.Fatalf("wrong exit code. expected 2")
 	}
 
 	// Given that we give relative paths back to the user, normalize this temp
@@ -468,8 +468,8 @@ func TestFmt_checkStdin(t *testing.T) {
 		"-check",
 		"-",
 	}
-	if code := c.Run(args); code != 3 {
-		t.Fatalf("wrong exit code. expected 3, got %d", code)
+	if code := c.Run(args); code != 2 {
+		t.Fatalf("wrong exit code. expected 2, got %d", code)
 	}
 
 	if ui.OutputWriter != nil {
@@ -477,6 +477,108 @@ func TestFmt_checkStdin(t *testing.T) {
 	}
 }
 
+func TestFmt_checkFormatted(t *testing.T) {
+	tempDir := testTempDir(t)
+
+	err := os.WriteFile(filepath.Join(tempDir, "main.tf"), fmtFixture.golden, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-check",
+		"-diff",
+		tempDir,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("wrong exit code. expected 0, got %d: \n%s", code, ui.ErrorWriter.String())
+	}
+
+	if ui.OutputWriter != nil {
+		t.Fatalf("expected no output, got: %q", ui.OutputWriter.String())
+	}
+}
+
+func TestFmt_checkDiff(t *testing.T) {
+	tempDir := fmtFixtureWriteDir(t)
+
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-check",
+		"-diff",
+		"-list=false",
+		tempDir,
+	}
+	if code := c.Run(args); code != 2 {
+		t.Fatalf("wrong exit code. expected 2, got %d: \n%s", code, ui.ErrorWriter.String())
+	}
+
+	expected := fmt.Sprintf("-%s+%s", fmtFixture.input, fmtFixture.golden)
+	if actual := ui.OutputWriter.String(); !strings.Contains(actual, expected) {
+		t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+	}
+
+	// -check must never modify the files.
+	got, err := os.ReadFile(filepath.Join(tempDir, fmtFixture.filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, fmtFixture.input) {
+		t.Fatalf("file was modified:\n%s", got)
+	}
+}
+
+func TestFmt_checkSyntaxError(t *testing.T) {
+	tempDir := fmtFixtureWriteDir(t)
+
+	err := os.WriteFile(filepath.Join(tempDir, "invalid.tf"), []byte("a = 1 +\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ui := new(cli.MockUi)
+	c := &FmtCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-check",
+		"-diff",
+		tempDir,
+	}
+	// A syntax error takes precedence over other files needing formatting.
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("wrong exit code. expected 1, got %d", code)
+	}
+
+	expected := "Invalid expression"
+	if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+		t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+	}
+	// Diffs are only shown for files that are valid.
+	if actual := ui.OutputWriter.String(); strings.Contains(actual, "invalid.tf") {
+		t.Fatalf("unexpected output for the invalid file:\n%s", actual)
+	}
+}
+
 var fmtFixture = struct {
 	filename      string
 	altFilename   string
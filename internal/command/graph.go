@@ -9,11 +9,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/dag"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/plans/planfile"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
@@ -200,6 +204,24 @@ func (c *GraphCommand) Run(args []string) int {
 			}
 		}
 
+		g, graphDiags = lr.Core.ApplyGraphForUI(plan, lr.Config)
+	case "apply-destroy":
+		plan := lr.Plan
+
+		// Without a saved plan we render the graph that applying a destroy
+		// plan for everything in the current state would use, which is
+		// enough to show the order in which objects would be destroyed.
+		if plan == nil {
+			plan = destroyPlanForGraph(lr.InputState)
+		} else if plan.UIMode != plans.DestroyMode {
+			graphDiags = graphDiags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Not a destroy plan",
+				"The -type=apply-destroy graph can only be rendered for a plan created with -destroy. Use -type=apply to render the graph for this plan.",
+			))
+			break
+		}
+
 		g, graphDiags = lr.Core.ApplyGraphForUI(plan, lr.Config)
 	case "eval", "validate":
 		// Terraform v0.12 through v1.0 supported both of these, but the
@@ -215,7 +237,7 @@ func (c *GraphCommand) Run(args []string) int {
 		graphDiags = graphDiags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Unsupported graph type",
-			`The -type=... argument must be either "plan", "plan-refresh-only", "plan-destroy", or "apply".`,
+			`The -type=... argument must be either "plan", "plan-refresh-only", "plan-destroy", "apply", or "apply-destroy".`,
 		))
 	}
 	diags = diags.Append(graphDiags)
@@ -247,6 +269,54 @@ func (c *GraphCommand) Run(args []string) int {
 	return 0
 }
 
+// destroyPlanForGraph returns a plan that destroys every managed resource
+// instance object in the given state, for rendering the apply graph of a
+// destroy operation without first creating a destroy plan.
+//
+// The changes in the result have no meaningful values, so it is only suitable
+// for building a graph to show to the user.
+func destroyPlanForGraph(state *states.State) *plans.Plan {
+	changes := plans.NewChanges()
+	nullVal, _ := plans.NewDynamicValue(cty.NullVal(cty.DynamicPseudoType), cty.DynamicPseudoType)
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode {
+				continue
+			}
+			for key, is := range rs.Instances {
+				addr := rs.Addr.Instance(key)
+				deposedKeys := make([]states.DeposedKey, 0, len(is.Deposed)+1)
+				if is.Current != nil {
+					deposedKeys = append(deposedKeys, states.NotDeposed)
+				}
+				for dk := range is.Deposed {
+					deposedKeys = append(deposedKeys, dk)
+				}
+				for _, dk := range deposedKeys {
+					changes.Resources = append(changes.Resources, &plans.ResourceInstanceChangeSrc{
+						Addr:         addr,
+						PrevRunAddr:  addr,
+						DeposedKey:   dk,
+						ProviderAddr: rs.ProviderConfig,
+						ChangeSrc: plans.ChangeSrc{
+							Action: plans.Delete,
+							Before: nullVal,
+							After:  nullVal,
+						},
+					})
+				}
+			}
+		}
+	}
+
+	return &plans.Plan{
+		Changes:      changes,
+		UIMode:       plans.DestroyMode,
+		PriorState:   state,
+		PrevRunState: state,
+	}
+}
+
 func (c *GraphCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] graph [options]
@@ -267,8 +337,10 @@ Options:
                    This helps when diagnosing cycle errors.
 
   -type=plan       Type of graph to output. Can be: plan, plan-refresh-only,
-                   plan-destroy, or apply. By default OpenTofu chooses
-				   "plan", or "apply" if you also set the -plan=... option.
+                   plan-destroy, apply, or apply-destroy. By default OpenTofu
+                   chooses "plan", or "apply" if you also set the -plan=...
+                   option. Apply graphs label each resource instance with
+                   its planned action.
 
   -module-depth=n  (deprecated) In prior versions of OpenTofu, specified the
 				   depth of modules to show in the output.
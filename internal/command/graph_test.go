@@ -162,4 +162,50 @@ func TestGraph_plan(t *testing.T) {
 	if !strings.Contains(output, `provider[\"registry.opentofu.org/hashicorp/test\"]`) {
 		t.Fatalf("doesn't look like digraph: %s", output)
 	}
+	if want := `label = "test_instance.bar (destroy)\n(delete)"`; !strings.Contains(output, want) {
+		t.Fatalf("planned action label %q missing from output:\n%s", want, output)
+	}
+}
+
+func TestGraph_applyDestroy(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("graph"), td)
+	defer testChdir(t, td)()
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"foo","ami":"bar"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+		)
+	})
+	testStateFileDefault(t, state)
+
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(applyFixtureProvider()),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{"-type=apply-destroy"}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if want := `label = "test_instance.foo (destroy)\n(delete)"`; !strings.Contains(output, want) {
+		t.Fatalf("planned action label %q missing from output:\n%s", want, output)
+	}
 }
@@ -37,7 +37,7 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
-	var configPath string
+	var configPath, fromFile string
 	args = c.Meta.process(args)
 
 	cmdFlags := c.Meta.extendedFlagSet("import")
@@ -49,13 +49,19 @@ func (c *ImportCommand) Run(args []string) int {
 	cmdFlags.StringVar(&configPath, "config", pwd, "path")
 	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
 	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	cmdFlags.StringVar(&fromFile, "from-file", "", "path")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 
 	args = cmdFlags.Args()
-	if len(args) != 2 {
+	if fromFile != "" && len(args) != 0 {
+		c.Ui.Error("The import command expects no arguments when -from-file is used.")
+		cmdFlags.Usage()
+		return 1
+	}
+	if fromFile == "" && len(args) != 2 {
 		c.Ui.Error("The import command expects two arguments.")
 		cmdFlags.Usage()
 		return 1
@@ -63,29 +69,67 @@ func (c *ImportCommand) Run(args []string) int {
 
 	var diags tfdiags.Diagnostics
 
-	// Parse the provided resource address.
-	traversalSrc := []byte(args[0])
-	traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
-	diags = diags.Append(travDiags)
-	if travDiags.HasErrors() {
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
-		c.showDiagnostics(diags)
-		c.Ui.Info(importCommandInvalidAddressReference)
-		return 1
-	}
-	addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
-	diags = diags.Append(addrDiags)
-	if addrDiags.HasErrors() {
-		c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+	parallelism, parallelismDiags := arguments.ResolveParallelism(c.Meta.parallelism)
+	diags = diags.Append(parallelismDiags)
+	if parallelismDiags.HasErrors() {
 		c.showDiagnostics(diags)
-		c.Ui.Info(importCommandInvalidAddressReference)
 		return 1
 	}
+	c.Meta.parallelism = parallelism
+
+	// In batch mode, problems with individual entries don't stop the other
+	// entries from being imported. They are collected in batchDiags and
+	// failed, and reported at the end along with the entries that worked.
+	batch := fromFile != ""
+	var entries []importFileEntry
+	var batchDiags tfdiags.Diagnostics
+	var failed []string
+	if batch {
+		var fileDiags tfdiags.Diagnostics
+		entries, fileDiags = c.readImportFile(fromFile)
+		diags = diags.Append(fileDiags)
+		if fileDiags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
 
-	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
-		diags = diags.Append(errors.New("A managed resource address is required. Importing into a data resource is not allowed."))
-		c.showDiagnostics(diags)
-		return 1
+		valid := entries[:0]
+		for _, entry := range entries {
+			if entry.diags.HasErrors() {
+				batchDiags = batchDiags.Append(entry.diags)
+				failed = append(failed, entry.addrSrc)
+				continue
+			}
+			valid = append(valid, entry)
+		}
+		entries = valid
+	} else {
+		// Parse the provided resource address.
+		traversalSrc := []byte(args[0])
+		traversal, travDiags := hclsyntax.ParseTraversalAbs(traversalSrc, "<import-address>", hcl.Pos{Line: 1, Column: 1})
+		diags = diags.Append(travDiags)
+		if travDiags.HasErrors() {
+			c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+			c.showDiagnostics(diags)
+			c.Ui.Info(importCommandInvalidAddressReference)
+			return 1
+		}
+		addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
+		diags = diags.Append(addrDiags)
+		if addrDiags.HasErrors() {
+			c.registerSynthConfigSource("<import-address>", traversalSrc) // so we can include a source snippet
+			c.showDiagnostics(diags)
+			c.Ui.Info(importCommandInvalidAddressReference)
+			return 1
+		}
+
+		if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+			diags = diags.Append(errors.New("A managed resource address is required. Importing into a data resource is not allowed."))
+			c.showDiagnostics(diags)
+			return 1
+		}
+
+		entries = []importFileEntry{{addrSrc: args[0], addr: addr, id: args[1]}}
 	}
 
 	if !c.dirIsConfigPath(configPath) {
@@ -118,51 +162,103 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
-	// Verify that the given address points to something that exists in config.
-	// This is to reduce the risk that a typo in the resource address will
-	// import something that OpenTofu will want to immediately destroy on
+	// Verify that the given addresses point to something that exists in
+	// config. This is to reduce the risk that a typo in the resource address
+	// will import something that OpenTofu will want to immediately destroy on
 	// the next plan, and generally acts as a reassurance of user intent.
-	targetConfig := config.DescendentForInstance(addr.Module)
-	if targetConfig == nil {
-		modulePath := addr.Module.String()
-		diags = diags.Append(&hcl.Diagnostic{
-			Severity: hcl.DiagError,
-			Summary:  "Import to non-existent module",
-			Detail: fmt.Sprintf(
-				"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
-				modulePath,
-			),
-		})
-		c.showDiagnostics(diags)
-		return 1
-	}
-	targetMod := targetConfig.Module
-	rcs := targetMod.ManagedResources
-	var rc *configs.Resource
-	resourceRelAddr := addr.Resource.Resource
-	for _, thisRc := range rcs {
-		if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
-			rc = thisRc
-			break
+	var targets []*tofu.ImportTarget
+	var importing []importFileEntry
+	seen := make(map[string]*hcl.Range)
+	for _, entry := range entries {
+		addr := entry.addr
+		if prev, exists := seen[addr.String()]; exists {
+			batchDiags = batchDiags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate import address",
+				Detail:   fmt.Sprintf("Resource address %s is also imported at %s. Each resource instance can be imported only once.", addr, prev),
+				Subject:  entry.rng,
+			})
+			failed = append(failed, entry.addrSrc)
+			continue
 		}
-	}
-	if rc == nil {
-		modulePath := addr.Module.String()
-		if modulePath == "" {
-			modulePath = "the root module"
+		seen[addr.String()] = entry.rng
+		targetConfig := config.DescendentForInstance(addr.Module)
+		if targetConfig == nil {
+			modulePath := addr.Module.String()
+			diag := &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Import to non-existent module",
+				Detail: fmt.Sprintf(
+					"%s is not defined in the configuration. Please add configuration for this module before importing into it.",
+					modulePath,
+				),
+				Subject: entry.rng,
+			}
+			if batch {
+				batchDiags = batchDiags.Append(diag)
+				failed = append(failed, entry.addrSrc)
+				continue
+			}
+			diags = diags.Append(diag)
+			c.showDiagnostics(diags)
+			return 1
+		}
+		targetMod := targetConfig.Module
+		rcs := targetMod.ManagedResources
+		var rc *configs.Resource
+		resourceRelAddr := addr.Resource.Resource
+		for _, thisRc := range rcs {
+			if resourceRelAddr.Type == thisRc.Type && resourceRelAddr.Name == thisRc.Name {
+				rc = thisRc
+				break
+			}
 		}
+		if rc == nil {
+			modulePath := addr.Module.String()
+			if modulePath == "" {
+				modulePath = "the root module"
+			}
+
+			if batch {
+				batchDiags = batchDiags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Import to non-existent resource",
+					Detail: fmt.Sprintf(
+						"Resource address %q does not exist in the configuration. Before importing this resource, please create its configuration in %s.",
+						addr, modulePath,
+					),
+					Subject: entry.rng,
+				})
+				failed = append(failed, entry.addrSrc)
+				continue
+			}
 
-		c.showDiagnostics(diags)
+			c.showDiagnostics(diags)
 
-		// This is not a diagnostic because currently our diagnostics printer
-		// doesn't support having a code example in the detail, and there's
-		// a code example in this message.
-		// TODO: Improve the diagnostics printer so we can use it for this
-		// message.
-		c.Ui.Error(fmt.Sprintf(
-			importCommandMissingResourceFmt,
-			addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name,
-		))
+			// This is not a diagnostic because currently our diagnostics printer
+			// doesn't support having a code example in the detail, and there's
+			// a code example in this message.
+			// TODO: Improve the diagnostics printer so we can use it for this
+			// message.
+			c.Ui.Error(fmt.Sprintf(
+				importCommandMissingResourceFmt,
+				addr, modulePath, resourceRelAddr.Type, resourceRelAddr.Name,
+			))
+			return 1
+		}
+
+		targets = append(targets, &tofu.ImportTarget{
+			CommandLineImportTarget: &tofu.CommandLineImportTarget{
+				Addr: addr,
+				ID:   entry.id,
+			},
+		})
+		importing = append(importing, entry)
+	}
+	if batch && len(targets) == 0 {
+		diags = diags.Append(batchDiags)
+		c.showDiagnostics(diags)
+		c.Ui.Error(fmt.Sprintf("None of the %d resources in %s could be imported.", len(failed), fromFile))
 		return 1
 	}
 
@@ -240,28 +336,44 @@ func (c *ImportCommand) Run(args []string) int {
 		}
 	}()
 
-	// Perform the import. Note that as you can see it is possible for this
-	// API to import more than one resource at once. For now, we only allow
-	// one while we stabilize this feature.
+	// Perform the import. All of the targets are imported in a single walk,
+	// so each provider is configured only once however many resources are
+	// being imported.
 	newState, importDiags := lr.Core.Import(lr.Config, lr.InputState, &tofu.ImportOpts{
-		Targets: []*tofu.ImportTarget{
-			{
-				CommandLineImportTarget: &tofu.CommandLineImportTarget{
-					Addr: addr,
-					ID:   args[1],
-				},
-			},
-		},
+		Targets: targets,
 
 		// The LocalRun idea is designed around our primary operations, so
 		// the input variables end up represented as plan options even though
 		// this particular operation isn't really a plan.
 		SetVariables: lr.PlanOpts.SetVariables,
+
+		// In batch mode we keep whichever imports succeeded, and report
+		// the others once the state has been saved.
+		KeepPartialState: batch,
 	})
-	diags = diags.Append(importDiags)
-	if diags.HasErrors() {
-		c.showDiagnostics(diags)
-		return 1
+	var imported []importFileEntry
+	if batch {
+		batchDiags = batchDiags.Append(importDiags)
+		for _, entry := range importing {
+			existed := lr.InputState != nil && lr.InputState.ResourceInstance(entry.addr) != nil
+			if !existed && newState != nil && newState.ResourceInstance(entry.addr) != nil {
+				imported = append(imported, entry)
+			} else {
+				failed = append(failed, entry.addrSrc)
+			}
+		}
+		if len(imported) == 0 {
+			diags = diags.Append(batchDiags)
+			c.showDiagnostics(diags)
+			c.Ui.Error(fmt.Sprintf("None of the %d resources in %s could be imported.", len(failed), fromFile))
+			return 1
+		}
+	} else {
+		diags = diags.Append(importDiags)
+		if diags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
 	}
 
 	// Get schemas, if possible, before writing state
@@ -283,6 +395,17 @@ func (c *ImportCommand) Run(args []string) int {
 		return 1
 	}
 
+	if batch {
+		diags = diags.Append(batchDiags)
+		c.showDiagnostics(diags)
+		c.showImportFileSummary(imported, failed)
+		if len(failed) != 0 {
+			return 1
+		}
+		c.Ui.Output(c.Colorize().Color("[reset][green]\n" + importCommandSuccessMsg))
+		return 0
+	}
+
 	c.Ui.Output(c.Colorize().Color("[reset][green]\n" + importCommandSuccessMsg))
 
 	c.showDiagnostics(diags)
@@ -293,9 +416,159 @@ func (c *ImportCommand) Run(args []string) int {
 	return 0
 }
 
+// importFileEntry is a single resource to import, either from the command
+// line arguments or from a line of the file given with -from-file.
+type importFileEntry struct {
+	// addrSrc is the address as written by the user.
+	addrSrc string
+	addr    addrs.AbsResourceInstance
+	id      string
+
+	// rng is the location of the address in the -from-file file, or nil
+	// for the command line arguments.
+	rng *hcl.Range
+
+	// diags contains any problems with the entry found while reading the
+	// file, in which case addr is invalid.
+	diags tfdiags.Diagnostics
+}
+
+// readImportFile reads the file given with -from-file. Each line of the file
+// that isn't empty or a comment starting with "#" gives the address to import
+// into and the resource-specific ID to import, separated by whitespace, just
+// like the arguments of the import command.
+//
+// Problems with individual lines are returned in the diags of the
+// corresponding entry, so that the remaining lines can still be imported. The
+// returned diagnostics are only for problems with the file as a whole.
+func (c *ImportCommand) readImportFile(filename string) ([]importFileEntry, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read import file",
+			fmt.Sprintf("Could not read %s: %s.", filename, err),
+		))
+	}
+	c.registerSynthConfigSource(filename, src) // so we can include source snippets
+
+	var entries []importFileEntry
+	offset := 0
+	for i, line := range strings.SplitAfter(string(src), "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := strings.Index(line, trimmed)
+		start := hcl.Pos{Line: i + 1, Column: indent + 1, Byte: lineStart + indent}
+
+		addrSrc, id := splitImportFileLine(trimmed)
+		entry := importFileEntry{
+			addrSrc: addrSrc,
+			id:      id,
+			rng: &hcl.Range{
+				Filename: filename,
+				Start:    start,
+				End:      hcl.Pos{Line: start.Line, Column: start.Column + len(addrSrc), Byte: start.Byte + len(addrSrc)},
+			},
+		}
+		if id == "" {
+			entry.diags = entry.diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Missing import ID",
+				Detail:   "Each line of an import file must contain a resource address and the ID of the object to import into it, separated by whitespace.",
+				Subject:  entry.rng,
+			})
+			entries = append(entries, entry)
+			continue
+		}
+
+		traversal, travDiags := hclsyntax.ParseTraversalAbs([]byte(addrSrc), filename, start)
+		entry.diags = entry.diags.Append(travDiags)
+		if !travDiags.HasErrors() {
+			addr, addrDiags := addrs.ParseAbsResourceInstance(traversal)
+			entry.diags = entry.diags.Append(addrDiags)
+			if !addrDiags.HasErrors() && addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+				entry.diags = entry.diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid import address",
+					Detail:   "A managed resource address is required. Importing into a data resource is not allowed.",
+					Subject:  entry.rng,
+				})
+			}
+			entry.addr = addr
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No resources to import",
+			fmt.Sprintf("The import file %s does not contain any resource addresses.", filename),
+		))
+	}
+	return entries, diags
+}
+
+// splitImportFileLine splits a line of an import file into the resource
+// address and the ID to import. The address ends at the first whitespace
+// that isn't inside an index, so that instance keys may contain spaces.
+func splitImportFileLine(line string) (addr, id string) {
+	depth := 0
+	inString := false
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case inString:
+			switch ch {
+			case '\\':
+				i++
+			case '"':
+				inString = false
+			}
+		case ch == '"':
+			inString = true
+		case ch == '[':
+			depth++
+		case ch == ']':
+			depth--
+		case depth == 0 && (ch == ' ' || ch == '\t'):
+			return line[:i], strings.TrimSpace(line[i:])
+		}
+	}
+	return line, ""
+}
+
+// showImportFileSummary reports which of the resources from the -from-file
+// file were imported and which were not.
+func (c *ImportCommand) showImportFileSummary(imported []importFileEntry, failed []string) {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "[reset][bold]Imported %d of %d resources.[reset]\n", len(imported), len(imported)+len(failed))
+	if len(imported) != 0 {
+		buf.WriteString("\nImported:\n")
+		for _, entry := range imported {
+			fmt.Fprintf(&buf, "  - %s (ID %q)\n", entry.addr, entry.id)
+		}
+	}
+	if len(failed) != 0 {
+		buf.WriteString("\nFailed, see the errors above:\n")
+		for _, addr := range failed {
+			fmt.Fprintf(&buf, "  - %s\n", addr)
+		}
+	}
+	c.Ui.Output(c.Colorize().Color(buf.String()))
+}
+
 func (c *ImportCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] import [options] ADDR ID
+       tofu [global options] import [options] -from-file=FILE
 
   Import existing infrastructure into your OpenTofu state.
 
@@ -314,6 +587,11 @@ Usage: tofu [global options] import [options] ADDR ID
   network requests to inspect parts of your infrastructure relevant to
   the resource being imported.
 
+  With -from-file, the resources to import are read from the given file
+  instead, which must contain an ADDR and ID on each line. They are all
+  imported in a single run, and if some of them can't be imported the
+  others are still imported and the failures are reported at the end.
+
 Options:
 
   -config=path            Path to a directory of OpenTofu configuration files
@@ -321,6 +599,11 @@ Options:
                           If no config files are present, they must be provided
                           via the input prompts or env vars.
 
+  -from-file=path         Import the resources given by the address and
+                          ID on each line of the file at the given path,
+                          rather than a single resource given as arguments.
+                          Empty lines and lines starting with # are ignored.
+
   -input=false            Disable interactive input prompts.
 
   -lock=false             Don't hold a state lock during the operation. This is
@@ -20,6 +20,7 @@ import (
 	"github.com/opentofu/opentofu/internal/copy"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/tfdiags"
+	"github.com/opentofu/opentofu/internal/tofu"
 )
 
 func TestImport(t *testing.T) {
@@ -77,6 +78,201 @@ func TestImport(t *testing.T) {
 	testStateOutput(t, statePath, testImportStr)
 }
 
+func TestImport_fromFile(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("import-from-file"), td)
+	defer testChdir(t, td)()
+
+	p := testImportFromFileProvider()
+	configureCount := 0
+	p.ConfigureProviderFn = func(providers.ConfigureProviderRequest) (resp providers.ConfigureProviderResponse) {
+		configureCount++
+		return resp
+	}
+
+	// Import both resources in one run
+	batchStatePath := testTempFile(t)
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &ImportCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+	args := []string{
+		"-state", batchStatePath,
+		"-from-file", "imports.txt",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if configureCount != 1 {
+		t.Errorf("provider configured %d times; want 1", configureCount)
+	}
+	output := ui.OutputWriter.String()
+	for _, want := range []string{
+		"Imported 2 of 2 resources.",
+		`  - test_instance.foo (ID "foo-id")`,
+		`  - test_instance.bar["a b"] (ID "bar id")`,
+		"Import successful!",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, output)
+		}
+	}
+
+	// The result must be the same as importing them one at a time
+	sequentialStatePath := testTempFile(t)
+	for _, args := range [][]string{
+		{"test_instance.foo", "foo-id"},
+		{`test_instance.bar["a b"]`, "bar id"},
+	} {
+		ui := new(cli.MockUi)
+		view, _ := testView(t)
+		c := &ImportCommand{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				Ui:               ui,
+				View:             view,
+			},
+		}
+		if code := c.Run(append([]string{"-state", sequentialStatePath}, args...)); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+	}
+
+	testStateOutput(t, batchStatePath, testImportFromFileStr)
+	testStateOutput(t, sequentialStatePath, testImportFromFileStr)
+}
+
+func TestImport_fromFilePartial(t *testing.T) {
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("import-from-file"), td)
+	defer testChdir(t, td)()
+
+	statePath := testTempFile(t)
+	p := testImportFromFileProvider()
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &ImportCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-from-file", "imports-partial.txt",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("expected error, got %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	// The resources that could be imported are in the state
+	testStateOutput(t, statePath, testImportFromFileStr)
+
+	output := ui.OutputWriter.String()
+	for _, want := range []string{
+		"Imported 2 of 6 resources.",
+		`  - test_instance.foo (ID "foo-id")`,
+		`  - test_instance.bar["a b"] (ID "bar id")`,
+		"Failed, see the errors above:\n" +
+			"  - data.test_instance.nope\n" +
+			"  - test_instance.bar[\"a b\"]\n" +
+			"  - test_instance.missing\n" +
+			"  - test_instance.fail\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Import successful!") {
+		t.Errorf("output should not report success:\n%s", output)
+	}
+
+	errors := ui.ErrorWriter.String()
+	for _, want := range []string{
+		"Error: Invalid import address",
+		"Error: Missing import ID",
+		"Error: Import to non-existent resource",
+		"Error: fail-id is not allowed",
+	} {
+		if !strings.Contains(errors, want) {
+			t.Errorf("errors don't contain %q:\n%s", want, errors)
+		}
+	}
+}
+
+func TestImport_fromFileWithArgs(t *testing.T) {
+	defer testChdir(t, testFixturePath("import-from-file"))()
+
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &ImportCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	args := []string{
+		"-from-file", "imports.txt",
+		"test_instance.foo",
+		"bar",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("expected error, got %d", code)
+	}
+	if got, want := ui.ErrorWriter.String(), "expects no arguments when -from-file is used"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func testImportFromFileProvider() *tofu.MockProvider {
+	p := testProvider()
+	p.ImportResourceStateFn = func(req providers.ImportResourceStateRequest) (resp providers.ImportResourceStateResponse) {
+		if req.ID == "fail-id" {
+			resp.Diagnostics = resp.Diagnostics.Append(fmt.Errorf("%s is not allowed", req.ID))
+			return resp
+		}
+		resp.ImportedResources = []providers.ImportedResource{
+			{
+				TypeName: req.TypeName,
+				State: cty.ObjectVal(map[string]cty.Value{
+					"id": cty.StringVal(req.ID),
+				}),
+			},
+		}
+		return resp
+	}
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+		DataSources: map[string]providers.Schema{
+			"test_instance": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+	}
+	return p
+}
+
 func TestImport_providerConfig(t *testing.T) {
 	defer testChdir(t, testFixturePath("import-provider"))()
 
@@ -982,3 +1178,12 @@ test_instance.foo:
   ID = yay
   provider = provider["registry.opentofu.org/hashicorp/test"]
 `
+
+const testImportFromFileStr = `
+test_instance.bar["a b"]:
+  ID = bar id
+  provider = provider["registry.opentofu.org/hashicorp/test"]
+test_instance.foo:
+  ID = foo-id
+  provider = provider["registry.opentofu.org/hashicorp/test"]
+`
@@ -63,6 +63,7 @@ func (c *InitCommand) Run(args []string) int {
 	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
 	cmdFlags.BoolVar(&c.reconfigure, "reconfigure", false, "reconfigure")
 	cmdFlags.BoolVar(&c.migrateState, "migrate-state", false, "migrate state")
+	cmdFlags.Var((*FlagStringSlice)(&c.migrateWorkspaces), "migrate-workspace", "migrate only the given workspace")
 	cmdFlags.BoolVar(&flagUpgrade, "upgrade", false, "")
 	cmdFlags.Var(&flagPluginPath, "plugin-dir", "plugin directory")
 	cmdFlags.StringVar(&flagLockfile, "lockfile", "", "Set a dependency lockfile mode")
@@ -103,9 +104,14 @@ func (c *InitCommand) Run(args []string) int {
 		return 1
 	}
 
+	if len(c.migrateWorkspaces) != 0 && c.reconfigure {
+		c.Ui.Error("The -migrate-workspace and -reconfigure options are mutually-exclusive")
+		return 1
+	}
+
 	// Copying the state only happens during backend migration, so setting
-	// -force-copy implies -migrate-state
-	if c.forceInitCopy {
+	// -force-copy or -migrate-workspace implies -migrate-state
+	if c.forceInitCopy || len(c.migrateWorkspaces) != 0 {
 		c.migrateState = true
 	}
 
@@ -1143,20 +1149,21 @@ func (c *InitCommand) AutocompleteArgs() complete.Predictor {
 
 func (c *InitCommand) AutocompleteFlags() complete.Flags {
 	return complete.Flags{
-		"-backend":        completePredictBoolean,
-		"-cloud":          completePredictBoolean,
-		"-backend-config": complete.PredictFiles("*.tfvars"), // can also be key=value, but we can't "predict" that
-		"-force-copy":     complete.PredictNothing,
-		"-from-module":    completePredictModuleSource,
-		"-get":            completePredictBoolean,
-		"-input":          completePredictBoolean,
-		"-lock":           completePredictBoolean,
-		"-lock-timeout":   complete.PredictAnything,
-		"-no-color":       complete.PredictNothing,
-		"-plugin-dir":     complete.PredictDirs(""),
-		"-reconfigure":    complete.PredictNothing,
-		"-migrate-state":  complete.PredictNothing,
-		"-upgrade":        completePredictBoolean,
+		"-backend":           completePredictBoolean,
+		"-cloud":             completePredictBoolean,
+		"-backend-config":    complete.PredictFiles("*.tfvars"), // can also be key=value, but we can't "predict" that
+		"-force-copy":        complete.PredictNothing,
+		"-from-module":       completePredictModuleSource,
+		"-get":               completePredictBoolean,
+		"-input":             completePredictBoolean,
+		"-lock":              completePredictBoolean,
+		"-lock-timeout":      complete.PredictAnything,
+		"-no-color":          complete.PredictNothing,
+		"-plugin-dir":        complete.PredictDirs(""),
+		"-reconfigure":       complete.PredictNothing,
+		"-migrate-state":     complete.PredictNothing,
+		"-migrate-workspace": complete.PredictAnything,
+		"-upgrade":           completePredictBoolean,
 	}
 }
 
@@ -1226,6 +1233,13 @@ Options:
   -migrate-state          Reconfigure a backend, and attempt to migrate any
                           existing state.
 
+  -migrate-workspace=NAME Like -migrate-state, but migrate only the named
+                          workspace, leaving any other workspaces in the
+                          previous backend. Use this option multiple times to
+                          migrate more than one workspace. Fails if any of the
+                          workspaces already has state in the new backend,
+                          unless -force-copy is also set.
+
   -upgrade                Install the latest module and provider versions
                           allowed within configured constraints, overriding the
                           default behavior of selecting exactly the version
@@ -925,6 +925,51 @@ func TestInit_backendReinitConfigToExtra(t *testing.T) {
 	}
 }
 
+// re-init with a backend block that reads its settings from env()
+func TestInit_backendReinitEnv(t *testing.T) {
+	td := t.TempDir()
+	defer testChdir(t, td)()
+
+	t.Setenv("TF_TEST_BACKEND_PATH", "foo")
+	cfg := "terraform {\n  backend \"local\" {\n    path = env(\"TF_TEST_BACKEND_PATH\")\n  }\n}\n"
+	if err := os.WriteFile("main.tf", []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &InitCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	args := []string{"-input=false", "-backend-config=workspace_dir=envs"}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	c = &InitCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+			View:             view,
+		},
+	}
+
+	// The override makes init compare the given configuration with the
+	// cached one, which must evaluate env() and find nothing to migrate.
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+	state := testDataStateRead(t, filepath.Join(DefaultDataDir, DefaultStateFilename))
+	if got, want := normalizeJSON(t, state.Backend.ConfigRaw), `{"path":"foo","workspace_dir":"envs"}`; got != want {
+		t.Errorf("wrong config\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
 func TestInit_backendCloudInvalidOptions(t *testing.T) {
 	// There are various "tofu init" options that are only for
 	// traditional backends and not applicable to Terraform Cloud mode.
@@ -99,6 +99,14 @@ type RenderHumanOpts struct {
 
 	// ShowSensitive is used to display the value of variables marked as sensitive.
 	ShowSensitive bool
+
+	// Concise tells the Renderer to leave out unchanged attributes and
+	// elements entirely, including the important attributes that are
+	// otherwise always displayed, rather than summarizing them with a count.
+	// Unchanged blocks are still summarized with a single line.
+	//
+	// ShowUnchangedChildren takes precedence over this option.
+	Concise bool
 }
 
 // NewRenderHumanOpts creates a new RenderHumanOpts struct with the required
@@ -126,5 +134,6 @@ func (opts RenderHumanOpts) Clone() RenderHumanOpts {
 		// an ancestor making the switch and affecting the entire tree.
 		OverrideForcesReplacement: false,
 		ShowSensitive:             opts.ShowSensitive,
+		Concise:                   opts.Concise,
 	}
 }
@@ -59,11 +59,11 @@ func (renderer blockRenderer) RenderHuman(diff computed.Diff, indent int, opts c
 	maximumAttributeKeyLen := 0
 	var attributeKeys []string
 	escapedAttributeKeys := make(map[string]string)
-	for key := range renderer.attributes {
+	for key, attribute := range renderer.attributes {
 		attributeKeys = append(attributeKeys, key)
 		escapedKey := EnsureValidAttributeName(key)
 		escapedAttributeKeys[key] = escapedKey
-		if maximumAttributeKeyLen < len(escapedKey) {
+		if maximumAttributeKeyLen < len(escapedKey) && !hiddenByConcise(attribute, opts) {
 			maximumAttributeKeyLen = len(escapedKey)
 		}
 	}
@@ -74,16 +74,26 @@ func (renderer blockRenderer) RenderHuman(diff computed.Diff, indent int, opts c
 
 	attributeOpts := opts.Clone()
 
+	// wroteAttributes tracks whether we've written anything about our
+	// attributes, since we might leave them all out when being concise.
+	wroteAttributes := false
+
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("{%s\n", forcesReplacement(diff.Replace, opts)))
 	for _, key := range attributeKeys {
 		attribute := renderer.attributes[key]
+		if hiddenByConcise(attribute, opts) {
+			// Only display the attributes that are changing, even the
+			// important ones, when we're being concise.
+			continue
+		}
 		if importantAttribute(key) {
 			// Always display the important attributes.
 			for _, warning := range attribute.WarningsHuman(indent+1, importantAttributeOpts) {
 				buf.WriteString(fmt.Sprintf("%s%s\n", formatIndent(indent+1), warning))
 			}
 			buf.WriteString(fmt.Sprintf("%s%s%-*s = %s\n", formatIndent(indent+1), writeDiffActionSymbol(attribute.Action, importantAttributeOpts), maximumAttributeKeyLen, key, attribute.RenderHuman(indent+1, importantAttributeOpts)))
+			wroteAttributes = true
 			continue
 		}
 		if attribute.Action == plans.NoOp && !opts.ShowUnchangedChildren {
@@ -95,10 +105,12 @@ func (renderer blockRenderer) RenderHuman(diff computed.Diff, indent int, opts c
 			buf.WriteString(fmt.Sprintf("%s%s\n", formatIndent(indent+1), warning))
 		}
 		buf.WriteString(fmt.Sprintf("%s%s%-*s = %s\n", formatIndent(indent+1), writeDiffActionSymbol(attribute.Action, attributeOpts), maximumAttributeKeyLen, escapedAttributeKeys[key], attribute.RenderHuman(indent+1, attributeOpts)))
+		wroteAttributes = true
 	}
 
 	if unchangedAttributes > 0 {
 		buf.WriteString(fmt.Sprintf("%s%s%s\n", formatIndent(indent+1), writeDiffActionSymbol(plans.NoOp, opts), unchanged("attribute", unchangedAttributes, opts)))
+		wroteAttributes = true
 	}
 
 	blockKeys := renderer.blocks.GetAllKeys()
@@ -125,7 +137,7 @@ func (renderer blockRenderer) RenderHuman(diff computed.Diff, indent int, opts c
 				return
 			}
 
-			if !foundChangedBlock && len(renderer.attributes) > 0 {
+			if !foundChangedBlock && wroteAttributes {
 				// We always want to put an extra new line between the
 				// attributes and blocks, and between groups of blocks.
 				buf.WriteString("\n")
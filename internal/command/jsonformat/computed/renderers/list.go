@@ -75,7 +75,7 @@ func (renderer listRenderer) RenderHuman(diff computed.Diff, indent int, opts co
 			// we skipped. Note, this is the length of the unchanged elements
 			// minus 1 as the most recent unchanged element will be printed out
 			// in full.
-			if len(unchangedElements) > 1 {
+			if len(unchangedElements) > 1 && !opts.Concise {
 				buf.WriteString(fmt.Sprintf("%s%s%s\n", formatIndent(indent+1), writeDiffActionSymbol(plans.NoOp, opts), unchanged("element", len(unchangedElements)-1, opts)))
 			}
 			// If our list of unchanged elements contains at least one entry,
@@ -120,7 +120,7 @@ func (renderer listRenderer) RenderHuman(diff computed.Diff, indent int, opts co
 	//
 	// If we were displaying context, then this will contain any unchanged
 	// elements since our last change, so we should also print it out.
-	if len(unchangedElements) > 0 {
+	if len(unchangedElements) > 0 && !opts.Concise {
 		buf.WriteString(fmt.Sprintf("%s%s%s\n", formatIndent(indent+1), writeDiffActionSymbol(plans.NoOp, opts), unchanged("element", len(unchangedElements), opts)))
 	}
 
@@ -103,7 +103,7 @@ func (renderer mapRenderer) RenderHuman(diff computed.Diff, indent int, opts com
 
 	}
 
-	if unchangedElements > 0 {
+	if unchangedElements > 0 && !opts.Concise {
 		buf.WriteString(fmt.Sprintf("%s%s%s\n", formatIndent(indent+1), writeDiffActionSymbol(plans.NoOp, opts), unchanged("element", unchangedElements, opts)))
 	}
 
@@ -52,11 +52,11 @@ func (renderer objectRenderer) RenderHuman(diff computed.Diff, indent int, opts
 	maximumKeyLen := 0
 	var keys []string
 	escapedKeys := make(map[string]string)
-	for key := range renderer.attributes {
+	for key, attribute := range renderer.attributes {
 		keys = append(keys, key)
 		escapedKey := EnsureValidAttributeName(key)
 		escapedKeys[key] = escapedKey
-		if maximumKeyLen < len(escapedKey) {
+		if maximumKeyLen < len(escapedKey) && !hiddenByConcise(attribute, opts) {
 			maximumKeyLen = len(escapedKey)
 		}
 	}
@@ -68,6 +68,12 @@ func (renderer objectRenderer) RenderHuman(diff computed.Diff, indent int, opts
 	for _, key := range keys {
 		attribute := renderer.attributes[key]
 
+		if hiddenByConcise(attribute, opts) {
+			// Only display the attributes that are changing, even the
+			// important ones, when we're being concise.
+			continue
+		}
+
 		if importantAttribute(key) {
 			importantAttributeOpts := attributeOpts.Clone()
 			importantAttributeOpts.ShowUnchangedChildren = true
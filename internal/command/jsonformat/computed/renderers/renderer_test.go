@@ -2245,6 +2245,133 @@ jsonencode(
       + 1,
     ]
 `,
+		},
+		"concise_block_update": {
+			diff: computed.Diff{
+				Renderer: Block(map[string]computed.Diff{
+					"id": {
+						Renderer: Primitive("root", "root", cty.String),
+						Action:   plans.NoOp,
+					},
+					"unchanged": {
+						Renderer: Primitive("one", "one", cty.String),
+						Action:   plans.NoOp,
+					},
+					"changed": {
+						Renderer: Primitive("old", "new", cty.String),
+						Action:   plans.Update,
+					},
+					"map": {
+						Renderer: Map(map[string]computed.Diff{
+							"a": {
+								Renderer: Primitive("a", "a", cty.String),
+								Action:   plans.NoOp,
+							},
+							"b": {
+								Renderer: Primitive("b", "c", cty.String),
+								Action:   plans.Update,
+							},
+						}),
+						Action: plans.Update,
+					},
+					"object": {
+						Renderer: Object(map[string]computed.Diff{
+							"name": {
+								Renderer: Primitive("x", "x", cty.String),
+								Action:   plans.NoOp,
+							},
+							"size": {
+								Renderer: Primitive(json.Number("1"), json.Number("2"), cty.Number),
+								Action:   plans.Update,
+							},
+						}),
+						Action: plans.Update,
+					},
+				}, Blocks{
+					SingleBlocks: map[string]computed.Diff{
+						"nested_block": {
+							Renderer: Block(map[string]computed.Diff{
+								"string": {
+									Renderer: Primitive("one", "one", cty.String),
+									Action:   plans.NoOp,
+								},
+							}, Blocks{}),
+							Action: plans.NoOp,
+						},
+					},
+				}),
+				Action: plans.Update,
+			},
+			opts: computed.RenderHumanOpts{
+				Concise: true,
+			},
+			expected: `
+{
+      ~ changed = "old" -> "new"
+      ~ map     = {
+          ~ "b" = "b" -> "c"
+        }
+      ~ object  = {
+          ~ size = 1 -> 2
+        }
+
+        # (1 unchanged block hidden)
+    }`,
+		},
+		"concise_list_update": {
+			diff: computed.Diff{
+				Renderer: List([]computed.Diff{
+					{
+						Renderer: Primitive(json.Number("0"), json.Number("0"), cty.Number),
+						Action:   plans.NoOp,
+					},
+					{
+						Renderer: Primitive(json.Number("1"), json.Number("1"), cty.Number),
+						Action:   plans.NoOp,
+					},
+					{
+						Renderer: Primitive(json.Number("2"), json.Number("3"), cty.Number),
+						Action:   plans.Update,
+					},
+					{
+						Renderer: Primitive(json.Number("4"), json.Number("4"), cty.Number),
+						Action:   plans.NoOp,
+					},
+				}),
+				Action: plans.Update,
+			},
+			opts: computed.RenderHumanOpts{
+				Concise: true,
+			},
+			expected: `
+[
+        1,
+      ~ 2 -> 3,
+        4,
+    ]`,
+		},
+		"concise_create_shows_everything": {
+			diff: computed.Diff{
+				Renderer: Block(map[string]computed.Diff{
+					"id": {
+						Renderer: Primitive(nil, "root", cty.String),
+						Action:   plans.Create,
+					},
+					"string": {
+						Renderer: Primitive(nil, "one", cty.String),
+						Action:   plans.Create,
+					},
+				}, Blocks{}),
+				Action: plans.Create,
+			},
+			opts: computed.RenderHumanOpts{
+				Concise: true,
+			},
+			expected: `
+{
+      + id     = "root"
+      + string = "one"
+    }`,
 		},
 		"json_string_no_symbols": {
 			diff: computed.Diff{
@@ -68,7 +68,7 @@ func (renderer setRenderer) RenderHuman(diff computed.Diff, indent int, opts com
 		buf.WriteString(fmt.Sprintf("%s%s%s,\n", formatIndent(indent+1), writeDiffActionSymbol(element.Action, elementOpts), element.RenderHuman(indent+1, elementOpts)))
 	}
 
-	if unchangedElements > 0 {
+	if unchangedElements > 0 && !opts.Concise {
 		buf.WriteString(fmt.Sprintf("%s%s%s\n", formatIndent(indent+1), writeDiffActionSymbol(plans.NoOp, opts), unchanged("element", unchangedElements, opts)))
 	}
 
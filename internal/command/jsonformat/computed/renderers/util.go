@@ -60,6 +60,12 @@ func unchanged(keyword string, count int, opts computed.RenderHumanOpts) string
 	return opts.Colorize.Color(fmt.Sprintf("[dark_gray]# (%d unchanged %ss hidden)[reset]", count, keyword))
 }
 
+// hiddenByConcise returns true if the given attribute isn't displayed at all
+// because it's unchanged and we're only displaying changes.
+func hiddenByConcise(attribute computed.Diff, opts computed.RenderHumanOpts) bool {
+	return opts.Concise && !opts.ShowUnchangedChildren && attribute.Action == plans.NoOp
+}
+
 // EnsureValidAttributeName checks if `name` contains any HCL syntax and calls
 // and returns hclEscapeString.
 func EnsureValidAttributeName(name string) string {
@@ -271,10 +271,13 @@ func renderHumanDiffOutputs(renderer Renderer, outputs map[string]computed.Diff)
 	}
 	sort.Strings(keys)
 
+	opts := computed.NewRenderHumanOpts(renderer.Colorize, renderer.ShowSensitive)
+	opts.Concise = renderer.Concise
+
 	for _, key := range keys {
 		output := outputs[key]
 		if output.Action != plans.NoOp {
-			rendered = append(rendered, fmt.Sprintf("%s %-*s = %s", renderer.Colorize.Color(format.DiffActionSymbol(output.Action)), escapedKeyMaxLen, escapedKeys[key], output.RenderHuman(0, computed.NewRenderHumanOpts(renderer.Colorize, renderer.ShowSensitive))))
+			rendered = append(rendered, fmt.Sprintf("%s %-*s = %s", renderer.Colorize.Color(format.DiffActionSymbol(output.Action)), escapedKeyMaxLen, escapedKeys[key], output.RenderHuman(0, opts)))
 		}
 	}
 	return strings.Join(rendered, "\n")
@@ -357,6 +360,7 @@ func renderHumanDiff(renderer Renderer, diff diff, cause string) (string, bool)
 	buf.WriteString(renderer.Colorize.Color(resourceChangeComment(diff.change, action, cause)))
 
 	opts := computed.NewRenderHumanOpts(renderer.Colorize, renderer.ShowSensitive)
+	opts.Concise = renderer.Concise
 
 	if action == plans.Forget {
 		opts.HideDiffActionSymbols = true
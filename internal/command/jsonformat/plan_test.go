@@ -7103,6 +7103,97 @@ func TestResourceChange_moved(t *testing.T) {
 	runTestCases(t, testCases)
 }
 
+func TestResourceChange_concise(t *testing.T) {
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id":    {Type: cty.String, Computed: true},
+			"ami":   {Type: cty.String, Optional: true},
+			"size":  {Type: cty.Number, Optional: true},
+			"zone":  {Type: cty.String, Optional: true},
+			"owner": {Type: cty.String, Optional: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"disk": {
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"size": {Type: cty.Number, Optional: true},
+					},
+				},
+				Nesting: configschema.NestingList,
+			},
+		},
+	}
+	before := cty.ObjectVal(map[string]cty.Value{
+		"id":    cty.StringVal("i-02ae66f368e8518a9"),
+		"ami":   cty.StringVal("ami-BEFORE"),
+		"size":  cty.NumberIntVal(1),
+		"zone":  cty.StringVal("a"),
+		"owner": cty.StringVal("me"),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(10)}),
+		}),
+	})
+
+	testCases := map[string]testCase{
+		"update": {
+			Action: plans.Update,
+			Mode:   addrs.ManagedResourceMode,
+			Before: before,
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id":    cty.StringVal("i-02ae66f368e8518a9"),
+				"ami":   cty.StringVal("ami-BEFORE"),
+				"size":  cty.NumberIntVal(2),
+				"zone":  cty.StringVal("a"),
+				"owner": cty.StringVal("me"),
+				"disk": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(10)}),
+				}),
+			}),
+			Schema:          schema,
+			RequiredReplace: cty.NewPathSet(),
+			Concise:         true,
+			ExpectedOutput: `  # test_instance.example will be updated in-place
+  ~ resource "test_instance" "example" {
+      ~ size = 1 -> 2
+
+        # (1 unchanged block hidden)
+    }`,
+		},
+		"replace": {
+			Action:       plans.DeleteThenCreate,
+			ActionReason: plans.ResourceInstanceReplaceBecauseCannotUpdate,
+			Mode:         addrs.ManagedResourceMode,
+			Before:       before,
+			After: cty.ObjectVal(map[string]cty.Value{
+				"id":    cty.UnknownVal(cty.String),
+				"ami":   cty.StringVal("ami-AFTER"),
+				"size":  cty.NumberIntVal(1),
+				"zone":  cty.StringVal("a"),
+				"owner": cty.StringVal("me"),
+				"disk": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(20)}),
+				}),
+			}),
+			Schema: schema,
+			RequiredReplace: cty.NewPathSet(
+				cty.GetAttrPath("ami"),
+			),
+			Concise: true,
+			ExpectedOutput: `  # test_instance.example must be replaced
+-/+ resource "test_instance" "example" {
+      ~ ami = "ami-BEFORE" -> "ami-AFTER" # forces replacement
+      ~ id  = "i-02ae66f368e8518a9" -> (known after apply)
+
+      ~ disk {
+          ~ size = 10 -> 20
+        }
+    }`,
+		},
+	}
+
+	runTestCases(t, testCases)
+}
+
 type testCase struct {
 	Action          plans.Action
 	ActionReason    plans.ResourceInstanceChangeActionReason
@@ -7118,6 +7209,7 @@ type testCase struct {
 	RequiredReplace cty.PathSet
 	ExpectedOutput  string
 	PrevRunAddr     addrs.AbsResourceInstance
+	Concise         bool
 }
 
 func runTestCases(t *testing.T, testCases map[string]testCase) {
@@ -7210,7 +7302,7 @@ func runTestCases(t *testing.T, testCases map[string]testCase) {
 
 			jsonschemas := jsonprovider.MarshalForRenderer(tfschemas)
 			change := structured.FromJsonChange(jsonchanges[0].Change, attribute_path.AlwaysMatcher())
-			renderer := Renderer{Colorize: color}
+			renderer := Renderer{Colorize: color, Concise: tc.Concise}
 			diff := diff{
 				change: jsonchanges[0],
 				diff:   differ.ComputeDiffForBlock(change, jsonschemas[jsonchanges[0].ProviderName].ResourceSchemas[jsonchanges[0].Type].Block),
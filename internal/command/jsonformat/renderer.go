@@ -83,6 +83,10 @@ type Renderer struct {
 
 	RunningInAutomation bool
 	ShowSensitive       bool
+
+	// Concise makes the rendered plan changes show only the attributes that
+	// are changing, rather than summarizing the unchanged attributes.
+	Concise bool
 }
 
 func (renderer Renderer) RenderHumanPlan(plan Plan, mode plans.Mode, opts ...plans.Quality) {
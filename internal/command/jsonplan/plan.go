@@ -20,6 +20,7 @@ import (
 	"github.com/opentofu/opentofu/internal/command/jsonconfig"
 	"github.com/opentofu/opentofu/internal/command/jsonstate"
 	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/states/statefile"
@@ -501,6 +502,16 @@ func MarshalResourceChanges(resources []*plans.ResourceInstanceChangeSrc, schema
 			r.Deposed = rc.DeposedKey.String()
 		}
 
+		if !dataSource {
+			// The planned new state carries the configured timeouts, but a
+			// delete has no new state so we use the prior state instead.
+			timeoutsV := changeV.After
+			if timeoutsV == cty.NilVal || timeoutsV.IsNull() {
+				timeoutsV = changeV.Before
+			}
+			r.Timeouts = marshalResourceTimeouts(schema, timeoutsV)
+		}
+
 		key := addr.Resource.Key
 		if key != nil {
 			value := key.Value()
@@ -562,6 +573,41 @@ func MarshalResourceChanges(resources []*plans.ResourceInstanceChangeSrc, schema
 	return ret, nil
 }
 
+// marshalResourceTimeouts returns the durations from the "timeouts" block in
+// the given resource object, or nil if the schema doesn't declare a
+// "timeouts" block.
+func marshalResourceTimeouts(schema *configschema.Block, val cty.Value) *ResourceTimeouts {
+	blockS, ok := schema.BlockTypes["timeouts"]
+	if !ok || blockS.Nesting != configschema.NestingSingle {
+		return nil
+	}
+
+	ret := &ResourceTimeouts{}
+	if val == cty.NilVal || !val.IsKnown() || val.IsNull() {
+		return ret
+	}
+	block := val.GetAttr("timeouts")
+	if !block.IsKnown() || block.IsNull() {
+		return ret
+	}
+
+	duration := func(name string) *string {
+		if _, ok := blockS.Attributes[name]; !ok {
+			return nil
+		}
+		v := block.GetAttr(name)
+		if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+			return nil
+		}
+		s := v.AsString()
+		return &s
+	}
+	ret.Create = duration("create")
+	ret.Update = duration("update")
+	ret.Delete = duration("delete")
+	return ret
+}
+
 // MarshalOutputChanges converts the provided internal representation of
 // Changes objects into the structured JSON representation.
 //
@@ -16,6 +16,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/plans"
 )
 
@@ -514,3 +515,89 @@ func BenchmarkUnknownAsBool_9(b *testing.B) {
 		unknownAsBool(value)
 	}
 }
+
+func TestMarshalResourceTimeouts(t *testing.T) {
+	timeoutsSchema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {Type: cty.String, Computed: true},
+		},
+		BlockTypes: map[string]*configschema.NestedBlock{
+			"timeouts": {
+				Nesting: configschema.NestingSingle,
+				Block: configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"create": {Type: cty.String, Optional: true},
+						"delete": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	noTimeoutsSchema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"id": {Type: cty.String, Computed: true},
+		},
+	}
+	timeoutsType := timeoutsSchema.BlockTypes["timeouts"].ImpliedType()
+
+	tests := map[string]struct {
+		Schema *configschema.Block
+		Value  cty.Value
+		Want   string
+	}{
+		"no timeouts block in schema": {
+			noTimeoutsSchema,
+			cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("foo"),
+			}),
+			`null`,
+		},
+		"timeouts block not set": {
+			timeoutsSchema,
+			cty.ObjectVal(map[string]cty.Value{
+				"id":       cty.StringVal("foo"),
+				"timeouts": cty.NullVal(timeoutsType),
+			}),
+			`{"create":null,"update":null,"delete":null}`,
+		},
+		"some timeouts set": {
+			timeoutsSchema,
+			cty.ObjectVal(map[string]cty.Value{
+				"id": cty.StringVal("foo"),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{
+					"create": cty.StringVal("60m"),
+					"delete": cty.NullVal(cty.String),
+				}),
+			}),
+			`{"create":"60m","update":null,"delete":null}`,
+		},
+		"unknown timeout": {
+			timeoutsSchema,
+			cty.ObjectVal(map[string]cty.Value{
+				"id": cty.UnknownVal(cty.String),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{
+					"create": cty.UnknownVal(cty.String),
+					"delete": cty.StringVal("10m"),
+				}),
+			}),
+			`{"create":null,"update":null,"delete":"10m"}`,
+		},
+		"null object": {
+			timeoutsSchema,
+			cty.NullVal(timeoutsSchema.ImpliedType()),
+			`{"create":null,"update":null,"delete":null}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := json.Marshal(marshalResourceTimeouts(test.Schema, test.Value))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.Want, string(got)); diff != "" {
+				t.Errorf("wrong result\n%s", diff)
+			}
+		})
+	}
+}
@@ -94,4 +94,18 @@ type ResourceChange struct {
 	// information should be resilient to encountering unrecognized values
 	// and treat them as an unspecified reason.
 	ActionReason string `json:"action_reason,omitempty"`
+
+	// Timeouts describes the operation timeouts set in the resource's
+	// "timeouts" block. Omitted for data resources and for resource types
+	// whose schema doesn't declare a "timeouts" block.
+	Timeouts *ResourceTimeouts `json:"timeouts,omitempty"`
+}
+
+// ResourceTimeouts is the representation of the "timeouts" block that some
+// providers declare for their managed resource types. Each duration is given
+// as it was written in the configuration, or null if it wasn't set.
+type ResourceTimeouts struct {
+	Create *string `json:"create"`
+	Update *string `json:"update"`
+	Delete *string `json:"delete"`
 }
@@ -124,6 +124,11 @@ type Meta struct {
 	// longer any compelling reasons for folks to not lock their dependencies.
 	PluginCacheMayBreakDependencyLockFile bool
 
+	// ProviderSchemaCache enables caching provider schemas on disk in the
+	// data directory, so that later runs with the same locked provider
+	// packages can avoid asking each provider for its schema again.
+	ProviderSchemaCache bool
+
 	// ProviderSource allows determining the available versions of a provider
 	// and determines where a distribution package for a particular
 	// provider version can be obtained.
@@ -250,18 +255,30 @@ type Meta struct {
 	// migrateState confirms the user wishes to migrate from the prior backend
 	// configuration to a new configuration.
 	//
+	// migrateWorkspaces, if not empty, limits the state migration to the
+	// named workspaces, leaving any other workspaces in the prior backend.
+	//
 	// compactWarnings (-compact-warnings) selects a more compact presentation
 	// of warnings in the output when they are not accompanied by errors.
-	statePath        string
-	stateOutPath     string
-	backupPath       string
-	parallelism      int
-	stateLock        bool
-	stateLockTimeout time.Duration
-	forceInitCopy    bool
-	reconfigure      bool
-	migrateState     bool
-	compactWarnings  bool
+	statePath         string
+	stateOutPath      string
+	backupPath        string
+	parallelism       int
+	stateLock         bool
+	stateLockTimeout  time.Duration
+	forceInitCopy     bool
+	reconfigure       bool
+	migrateState      bool
+	migrateWorkspaces []string
+	compactWarnings   bool
+
+	// refreshParallelism is used instead of parallelism for walks that only
+	// refresh existing objects, if it is non-zero.
+	refreshParallelism int
+
+	// noSchemaCache disables the on-disk provider schema cache for this run
+	// even if ProviderSchemaCache is set.
+	noSchemaCache bool
 
 	// Used with commands which write state to allow users to write remote
 	// state even if the remote and local OpenTofu versions don't match.
@@ -546,6 +563,7 @@ func (m *Meta) contextOpts() (*tofu.ContextOpts, error) {
 
 	opts.UIInput = m.UIInput()
 	opts.Parallelism = m.parallelism
+	opts.RefreshParallelism = m.refreshParallelism
 
 	// If testingOverrides are set, we'll skip the plugin discovery process
 	// and just work with what we've been given, thus allowing the tests
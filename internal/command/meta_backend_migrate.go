@@ -13,6 +13,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -104,6 +105,21 @@ func (m *Meta) backendMigrateState(opts *backendMigrateOpts) error {
 		}
 	}
 
+	// Migrating only some of the workspaces is handled separately, since it
+	// only makes sense when both backends support multiple workspaces.
+	if len(m.migrateWorkspaces) != 0 {
+		switch {
+		case sourceTFC || destinationTFC:
+			return errors.New(strings.TrimSpace(errMigrateSubsetCloud))
+		case destinationSingleState:
+			return fmt.Errorf(strings.TrimSpace(errMigrateSubsetSingleState), opts.DestinationType)
+		}
+		if sourceSingleState {
+			sourceWorkspaces = []string{backend.DefaultStateName}
+		}
+		return m.backendMigrateState_subset(opts, sourceWorkspaces, destinationWorkspaces)
+	}
+
 	// Determine migration behavior based on whether the source/destination
 	// supports multi-state.
 	switch {
@@ -217,6 +233,133 @@ func (m *Meta) backendMigrateState_S_S(opts *backendMigrateOpts) error {
 	return nil
 }
 
+// Some of the workspaces of a multi-state backend to another multi-state
+// backend, as selected with the -migrate-workspace option.
+func (m *Meta) backendMigrateState_subset(opts *backendMigrateOpts, sourceWorkspaces, destinationWorkspaces []string) error {
+	log.Printf("[INFO] backendMigrateState: migrating only the workspaces %q", m.migrateWorkspaces)
+
+	selected := make(map[string]bool, len(m.migrateWorkspaces))
+	for _, name := range m.migrateWorkspaces {
+		selected[name] = true
+	}
+
+	var migrating, remaining []string
+	for _, name := range sourceWorkspaces {
+		if selected[name] {
+			migrating = append(migrating, name)
+			delete(selected, name)
+		} else {
+			remaining = append(remaining, name)
+		}
+	}
+	if len(selected) != 0 {
+		var missing []string
+		for name := range selected {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return fmt.Errorf(strings.TrimSpace(errMigrateSubsetMissing),
+			opts.SourceType, formatWorkspaceList(missing), formatWorkspaceList(sourceWorkspaces))
+	}
+	// Sort the states so they're always copied alphabetically
+	sort.Strings(migrating)
+	sort.Strings(remaining)
+
+	// The destination workspaces must not already have state, because we'd
+	// overwrite it, unless the user forced the copy.
+	if !opts.force {
+		var conflicts []string
+		for _, name := range destinationWorkspaces {
+			if !slices.Contains(migrating, name) {
+				continue
+			}
+			destinationState, err := opts.Destination.StateMgr(name)
+			if err != nil {
+				return fmt.Errorf(strings.TrimSpace(
+					errMigrateLoadStates), opts.DestinationType, err)
+			}
+			if err := destinationState.RefreshState(); err != nil {
+				return fmt.Errorf(strings.TrimSpace(
+					errMigrateLoadStates), opts.DestinationType, err)
+			}
+			if !destinationState.State().Empty() {
+				conflicts = append(conflicts, name)
+			}
+		}
+		if len(conflicts) != 0 {
+			sort.Strings(conflicts)
+			return fmt.Errorf(strings.TrimSpace(errMigrateSubsetExists),
+				opts.DestinationType, formatWorkspaceList(conflicts))
+		}
+
+		// Abort if we can't ask for input.
+		if !m.input {
+			log.Print("[TRACE] backendMigrateState: can't prompt for input, so aborting migration")
+			return errors.New(strings.TrimSpace(errInteractiveInputDisabled))
+		}
+
+		remainingDesc := "none"
+		if len(remaining) != 0 {
+			remainingDesc = formatWorkspaceList(remaining)
+		}
+		migrate, err := m.confirm(&tofu.InputOpts{
+			Id: "backend-migrate-multistate-subset",
+			Query: fmt.Sprintf(
+				"Do you want to migrate only the selected workspaces to %q?",
+				opts.DestinationType),
+			Description: fmt.Sprintf(
+				strings.TrimSpace(inputBackendMigrateSubset),
+				opts.SourceType, opts.DestinationType,
+				formatWorkspaceList(migrating), remainingDesc),
+		})
+		if err != nil {
+			return fmt.Errorf(
+				"Error asking for state migration action: %w", err)
+		}
+		if !migrate {
+			return fmt.Errorf("Migration aborted by user.")
+		}
+	}
+
+	for _, name := range migrating {
+		opts.sourceWorkspace = name
+		opts.destinationWorkspace = name
+
+		// Force it, we confirmed above
+		opts.force = true
+
+		if err := m.backendMigrateState_s_s(opts); err != nil {
+			return fmt.Errorf(strings.TrimSpace(
+				errMigrateSubset), name, opts.SourceType, opts.DestinationType, err)
+		}
+	}
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "Migrated the following workspaces to the %q backend:\n", opts.DestinationType)
+	for _, name := range migrating {
+		fmt.Fprintf(&summary, "  - %s\n", name)
+	}
+	if len(remaining) != 0 {
+		fmt.Fprintf(&summary, "\nThe following workspaces remain only in the previous %q backend:\n", opts.SourceType)
+		for _, name := range remaining {
+			fmt.Fprintf(&summary, "  - %s\n", name)
+		}
+	}
+	m.Ui.Output(summary.String())
+
+	return nil
+}
+
+// formatWorkspaceList returns the given workspace names as a
+// comma-separated list of quoted names.
+func formatWorkspaceList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 // Multi-state to single state.
 func (m *Meta) backendMigrateState_S_s(opts *backendMigrateOpts) error {
 	log.Printf("[INFO] backendMigrateState: destination backend type %q does not support named workspaces", opts.DestinationType)
@@ -972,6 +1115,55 @@ Please resolve the error above and run the initialization command again.
 This will attempt to copy (with permission) all workspaces again.
 `
 
+const errMigrateSubset = `
+Error migrating the workspace %q from the previous %q backend
+to the newly configured %q backend:
+    %w
+
+OpenTofu copies the selected workspaces in alphabetical order. Any selected
+workspaces alphabetically earlier than this one have been copied. Any
+workspaces later than this haven't been modified in the destination. No
+workspaces in the source state have been modified.
+
+Please resolve the error above and run the initialization command again.
+`
+
+const errMigrateSubsetMissing = `
+The previous %q backend has no workspaces named %s.
+
+The -migrate-workspace option must name existing workspaces. The available
+workspaces are: %s.
+`
+
+const errMigrateSubsetExists = `
+The newly configured %q backend already has state for the workspaces %s.
+
+OpenTofu won't overwrite existing state when migrating only some of the
+workspaces. Remove the workspaces from the -migrate-workspace options, or add
+the -force-copy option to overwrite their state in the new backend.
+`
+
+const errMigrateSubsetSingleState = `
+The newly configured %q backend doesn't support multiple workspaces, so
+the -migrate-workspace option can't be used.
+`
+
+const errMigrateSubsetCloud = `
+The -migrate-workspace option can't be used when migrating to or from the
+cloud backend.
+`
+
+const inputBackendMigrateSubset = `
+OpenTofu will copy only the selected workspaces from the previous %[1]q
+backend to the newly configured %[2]q backend. The other workspaces stay in
+the %[1]q backend, so you can migrate them later.
+
+Workspaces to migrate: %[3]s
+Workspaces that stay in the previous backend: %[4]s
+
+Enter "yes" to copy the selected workspaces and "no" to cancel.
+`
+
 const errBackendStateCopy = `
 Error copying state from the previous %q backend to the newly configured
 %q backend:
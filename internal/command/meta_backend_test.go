@@ -7,6 +7,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -1260,6 +1261,148 @@ func TestMetaBackend_configuredChangeCopy_multiToMulti(t *testing.T) {
 	}
 }
 
+// Changing a configured backend that supports multi-state to a
+// backend that also supports multi-state, migrating only some of the
+// workspaces.
+func TestMetaBackend_configuredChangeCopy_multiToMultiSubset(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-change-multi-to-multi"), td)
+	defer testChdir(t, td)()
+
+	// Ask input
+	defer testInputMap(t, map[string]string{
+		"backend-migrate-multistate-subset": "yes",
+	})()
+
+	// Setup the meta
+	ui := new(cli.MockUi)
+	m := testMetaBackend(t, nil)
+	m.Ui = ui
+	m.migrateWorkspaces = []string{"env2"}
+
+	// Get the backend
+	b, diags := m.Backend(&BackendOpts{Init: true}, encryption.StateEncryptionDisabled())
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	{
+		// The default state stays in the previous backend only
+		s, err := b.StateMgr(backend.DefaultStateName)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := s.RefreshState(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if state := s.State(); !state.Empty() {
+			t.Fatalf("default state should not have been migrated: %#v", state)
+		}
+	}
+
+	{
+		// Check the migrated state
+		s, err := b.StateMgr("env2")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err := s.RefreshState(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if testStateMgrCurrentLineage(s) != "backend-change-env2" {
+			t.Fatalf("bad: %#v", s.State())
+		}
+	}
+
+	output := ui.OutputWriter.String()
+	for _, want := range []string{
+		"Migrated the following workspaces to the \"local\" backend:\n  - env2\n",
+		"The following workspaces remain only in the previous \"local\" backend:\n  - default\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, output)
+		}
+	}
+}
+
+// Migrating only some of the workspaces must not overwrite existing state
+// in the destination unless forced.
+func TestMetaBackend_configuredChangeCopy_multiToMultiSubsetExisting(t *testing.T) {
+	for _, force := range []bool{false, true} {
+		t.Run(fmt.Sprintf("force=%t", force), func(t *testing.T) {
+			// Create a temporary working directory that is empty
+			td := t.TempDir()
+			testCopyDir(t, testFixturePath("backend-change-multi-to-multi"), td)
+			defer testChdir(t, td)()
+
+			// Put a different state in the destination workspace
+			existing := states.NewState()
+			existing.RootModule().SetOutputValue("existing", cty.StringVal("yes"), false)
+			if err := os.MkdirAll(filepath.Join("envdir-new", "env2"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := statemgr.WriteAndPersist(statemgr.NewFilesystem(filepath.Join("envdir-new", "env2", backendLocal.DefaultStateFilename), encryption.StateEncryptionDisabled()), existing, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			m := testMetaBackend(t, nil)
+			m.migrateWorkspaces = []string{"env2"}
+			m.forceInitCopy = force
+
+			b, diags := m.Backend(&BackendOpts{Init: true}, encryption.StateEncryptionDisabled())
+			if !force {
+				if !diags.HasErrors() {
+					t.Fatal("expected an error")
+				}
+				if got, want := diags.Err().Error(), `already has state for the workspaces "env2"`; !strings.Contains(got, want) {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+
+			s, err := b.StateMgr("env2")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := s.RefreshState(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if testStateMgrCurrentLineage(s) != "backend-change-env2" {
+				t.Fatalf("bad: %#v", s.State())
+			}
+		})
+	}
+}
+
+// Migrating a workspace that doesn't exist in the source backend fails.
+func TestMetaBackend_configuredChangeCopy_multiToMultiSubsetMissing(t *testing.T) {
+	// Create a temporary working directory that is empty
+	td := t.TempDir()
+	testCopyDir(t, testFixturePath("backend-change-multi-to-multi"), td)
+	defer testChdir(t, td)()
+
+	m := testMetaBackend(t, nil)
+	m.migrateWorkspaces = []string{"env2", "nope"}
+
+	_, diags := m.Backend(&BackendOpts{Init: true}, encryption.StateEncryptionDisabled())
+	if !diags.HasErrors() {
+		t.Fatal("expected an error")
+	}
+	if got, want := diags.Err().Error(), `has no workspaces named "nope"`; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+
+	// Nothing was migrated
+	envPath := filepath.Join("envdir-new", "env2", backendLocal.DefaultStateFilename)
+	if _, err := os.Stat(envPath); err == nil {
+		t.Fatalf("%s should not exist", envPath)
+	}
+}
+
 // Changing a configured backend that supports multi-state to a
 // backend that also supports multi-state, but doesn't allow a
 // default state while the default state is non-empty.
@@ -323,7 +323,11 @@ func (m *Meta) providerFactories() (map[addrs.Provider]providers.Factory, error)
 				continue
 			}
 		}
-		factories[provider] = providerFactory(cached)
+		factory := providerFactory(cached)
+		if m.providerSchemaCacheEnabled() {
+			factory = m.providerFactoryWithSchemaCache(provider, version, lock.PreferredHashes(), factory)
+		}
+		factories[provider] = factory
 	}
 	for provider, localDir := range devOverrideProviders {
 		factories[provider] = devOverrideProviderFactory(provider, localDir)
@@ -181,6 +181,19 @@ func (m *Meta) addVarsFromFile(filename string, sourceType tofu.ValueSourceType,
 	// Record the file source code for snippets in diagnostic messages.
 	loader.Parser().ForceFileSource(filename, src)
 
+	switch {
+	case strings.HasSuffix(filename, varsExtensionYAML), strings.HasSuffix(filename, varsExtensionYML):
+		attrs, hclDiags := decodeYAMLVarsFile(src, filename)
+		diags = diags.Append(hclDiags)
+		addVarsFromFileAttrs(attrs, sourceType, to)
+		return diags
+	case strings.HasSuffix(filename, varsExtensionTOML):
+		attrs, hclDiags := decodeTOMLVarsFile(src, filename)
+		diags = diags.Append(hclDiags)
+		addVarsFromFileAttrs(attrs, sourceType, to)
+		return diags
+	}
+
 	var f *hcl.File
 
 	extJSON := strings.HasSuffix(filename, ".json")
@@ -249,6 +262,15 @@ func (m *Meta) addVarsFromFile(filename string, sourceType tofu.ValueSourceType,
 	return diags
 }
 
+func addVarsFromFileAttrs(attrs varsFileAttrs, sourceType tofu.ValueSourceType, to map[string]backend.UnparsedVariableValue) {
+	for name, expr := range attrs {
+		to[name] = unparsedVariableValueExpression{
+			expr:       expr,
+			sourceType: sourceType,
+		}
+	}
+}
+
 // unparsedVariableValueExpression is a backend.UnparsedVariableValue
 // implementation that was actually already parsed (!). This is
 // intended to deal with expressions inside "tfvars" files.
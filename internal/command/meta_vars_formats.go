@@ -0,0 +1,348 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"gopkg.in/yaml.v3"
+)
+
+// Variables files with these extensions are decoded as YAML or TOML rather
+// than as HCL native syntax or JSON. The values they contain are given the
+// same types they'd have in the equivalent JSON document, so that they're
+// converted to the declared types of the variables in the same way.
+const (
+	varsExtensionYAML = ".yaml"
+	varsExtensionYML  = ".yml"
+	varsExtensionTOML = ".toml"
+)
+
+// varsFileAttrs is the result of decoding a YAML or TOML variables file: an
+// expression for the value of each top-level key.
+type varsFileAttrs map[string]hcl.Expression
+
+// decodeYAMLVarsFile decodes a YAML variables file, whose document must be a
+// mapping from variable names to values.
+//
+// Scalars are resolved using the YAML 1.2 core schema, so that only the
+// various spellings of true and false are booleans, while the YAML 1.1
+// booleans such as yes, no, on and off are strings.
+func decodeYAMLVarsFile(src []byte, filename string) (varsFileAttrs, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	lines := newVarsFileLines(src, filename)
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(src, &doc); err != nil {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid YAML variables file",
+			Detail:   fmt.Sprintf("The variables file could not be parsed as YAML: %s.", strings.TrimPrefix(err.Error(), "yaml: ")),
+			Subject:  lines.fileRange().Ptr(),
+		})
+		return nil, diags
+	}
+
+	attrs := make(varsFileAttrs)
+	if len(doc.Content) == 0 {
+		// An empty document sets no variables.
+		return attrs, diags
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid YAML variables file",
+			Detail:   "The root of a YAML variables file must be a mapping from variable names to their values.",
+			Subject:  lines.nodeRange(root, 0).Ptr(),
+		})
+		return nil, diags
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		rng := lines.nodeRange(key, len(key.Value))
+		if _, exists := attrs[key.Value]; exists {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate variable value",
+				Detail:   fmt.Sprintf("The variable %q is set more than once in this file.", key.Value),
+				Subject:  rng.Ptr(),
+			})
+			continue
+		}
+		val, err := yamlNodeValue(value)
+		if err != nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid YAML variables file",
+				Detail:   fmt.Sprintf("Invalid value for variable %q: %s.", key.Value, err),
+				Subject:  rng.Ptr(),
+			})
+			continue
+		}
+		attrs[key.Value] = hcl.StaticExpr(val, rng)
+	}
+	return attrs, diags
+}
+
+func yamlNodeValue(node *yaml.Node) (cty.Value, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return yamlNodeValue(node.Alias)
+
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		attrs := make(map[string]cty.Value, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind != yaml.ScalarNode {
+				return cty.NilVal, fmt.Errorf("line %d: mapping keys must be scalars", key.Line)
+			}
+			if key.ShortTag() == "!!merge" {
+				return cty.NilVal, fmt.Errorf("line %d: merge keys are not supported", key.Line)
+			}
+			if _, exists := attrs[key.Value]; exists {
+				return cty.NilVal, fmt.Errorf("line %d: duplicate key %q", key.Line, key.Value)
+			}
+			val, err := yamlNodeValue(value)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[key.Value] = val
+		}
+		return cty.ObjectVal(attrs), nil
+
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		elems := make([]cty.Value, len(node.Content))
+		for i, elem := range node.Content {
+			val, err := yamlNodeValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elems[i] = val
+		}
+		return cty.TupleVal(elems), nil
+
+	case yaml.ScalarNode:
+		return yamlScalarValue(node)
+
+	default:
+		return cty.NilVal, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+	}
+}
+
+func yamlScalarValue(node *yaml.Node) (cty.Value, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case "!!bool":
+		var v bool
+		if err := node.Decode(&v); err != nil {
+			return cty.NilVal, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		return cty.BoolVal(v), nil
+	case "!!int", "!!float":
+		// Plain decimal numbers are parsed exactly, as they would be in
+		// JSON, so that large numbers keep their precision.
+		if v, err := cty.ParseNumberVal(node.Value); err == nil {
+			return v, nil
+		}
+		// Other notations, like 0x1F, 0o17 and 1_000, are decoded by yaml.v3.
+		var v any
+		if err := node.Decode(&v); err != nil {
+			return cty.NilVal, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		switch v := v.(type) {
+		case int:
+			return cty.NumberIntVal(int64(v)), nil
+		case int64:
+			return cty.NumberIntVal(v), nil
+		case uint64:
+			return cty.NumberUIntVal(v), nil
+		case float64:
+			if !math.IsNaN(v) && !math.IsInf(v, 0) {
+				return cty.NumberFloatVal(v), nil
+			}
+		}
+		return cty.NilVal, fmt.Errorf("line %d: %s is not a valid number", node.Line, node.Value)
+	default:
+		// Everything else, including timestamps and binary data, is
+		// taken as the string it's written as.
+		return cty.StringVal(node.Value), nil
+	}
+}
+
+// decodeTOMLVarsFile decodes a TOML variables file, each of whose top-level
+// keys sets the variable of that name.
+//
+// Dates and times are taken as strings in RFC 3339 format, or as the
+// date, time or date and time they're written as when they have no offset.
+func decodeTOMLVarsFile(src []byte, filename string) (varsFileAttrs, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	lines := newVarsFileLines(src, filename)
+
+	var doc map[string]any
+	if _, err := toml.Decode(string(src), &doc); err != nil {
+		rng := lines.fileRange()
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			rng = lines.lineRange(parseErr.Position.Line)
+		}
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid TOML variables file",
+			Detail:   fmt.Sprintf("The variables file could not be parsed as TOML: %s.", strings.TrimPrefix(err.Error(), "toml: ")),
+			Subject:  rng.Ptr(),
+		})
+		return nil, diags
+	}
+
+	// The TOML decoder doesn't record where each key was defined, so all of
+	// the values refer to the whole file.
+	rng := lines.fileRange()
+	attrs := make(varsFileAttrs, len(doc))
+	for name, raw := range doc {
+		val, err := tomlValue(raw)
+		if err != nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid TOML variables file",
+				Detail:   fmt.Sprintf("Invalid value for variable %q: %s.", name, err),
+				Subject:  rng.Ptr(),
+			})
+			continue
+		}
+		attrs[name] = hcl.StaticExpr(val, rng)
+	}
+	return attrs, diags
+}
+
+func tomlValue(raw any) (cty.Value, error) {
+	switch v := raw.(type) {
+	case string:
+		return cty.StringVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case int64:
+		return cty.NumberIntVal(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return cty.NilVal, fmt.Errorf("%v is not a valid number", v)
+		}
+		return cty.NumberFloatVal(v), nil
+	case time.Time:
+		// The decoder marks dates and times written without an offset
+		// with special time zones, so that we can write them back the same
+		// way rather than inventing an offset.
+		switch v.Location().String() {
+		case "datetime-local":
+			return cty.StringVal(v.Format("2006-01-02T15:04:05.999999999")), nil
+		case "date-local":
+			return cty.StringVal(v.Format(time.DateOnly)), nil
+		case "time-local":
+			return cty.StringVal(v.Format("15:04:05.999999999")), nil
+		default:
+			return cty.StringVal(v.Format(time.RFC3339Nano)), nil
+		}
+	case map[string]any:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		attrs := make(map[string]cty.Value, len(v))
+		for k, elem := range v {
+			val, err := tomlValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[k] = val
+		}
+		return cty.ObjectVal(attrs), nil
+	case []map[string]any:
+		elems := make([]any, len(v))
+		for i, elem := range v {
+			elems[i] = elem
+		}
+		return tomlValue(elems)
+	case []any:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		elems := make([]cty.Value, len(v))
+		for i, elem := range v {
+			val, err := tomlValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elems[i] = val
+		}
+		return cty.TupleVal(elems), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported value of type %T", raw)
+	}
+}
+
+// varsFileLines converts the line and column positions reported by the YAML
+// and TOML decoders into source ranges for diagnostics.
+type varsFileLines struct {
+	filename string
+	size     int
+	starts   []int // byte offset of the start of each line
+}
+
+func newVarsFileLines(src []byte, filename string) varsFileLines {
+	starts := []int{0}
+	for i, b := range src {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return varsFileLines{filename: filename, size: len(src), starts: starts}
+}
+
+func (l varsFileLines) pos(line, column int) hcl.Pos {
+	if line < 1 || line > len(l.starts) {
+		return hcl.Pos{Line: 1, Column: 1}
+	}
+	if column < 1 {
+		column = 1
+	}
+	offset := min(l.starts[line-1]+column-1, l.size)
+	return hcl.Pos{Line: line, Column: column, Byte: offset}
+}
+
+func (l varsFileLines) nodeRange(node *yaml.Node, length int) hcl.Range {
+	return hcl.Range{
+		Filename: l.filename,
+		Start:    l.pos(node.Line, node.Column),
+		End:      l.pos(node.Line, node.Column+length),
+	}
+}
+
+func (l varsFileLines) lineRange(line int) hcl.Range {
+	start := l.pos(line, 1)
+	return hcl.Range{Filename: l.filename, Start: start, End: start}
+}
+
+func (l varsFileLines) fileRange() hcl.Range {
+	return hcl.Range{
+		Filename: l.filename,
+		Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+		End:      hcl.Pos{Line: 1, Column: 1, Byte: 0},
+	}
+}
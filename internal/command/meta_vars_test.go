@@ -8,9 +8,13 @@ package command
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/opentofu/opentofu/internal/backend"
+	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/tofu"
 )
 
@@ -20,6 +24,8 @@ func TestMeta_addVarsFromFile(t *testing.T) {
 
 	hclData := `foo = "bar"`
 	jsonData := `{"foo": "bar"}`
+	yamlData := `foo: bar`
+	tomlData := `foo = "bar"`
 
 	cases := []struct {
 		filename string
@@ -46,6 +52,31 @@ func TestMeta_addVarsFromFile(t *testing.T) {
 			contents: jsonData,
 			errors:   false,
 		},
+		{
+			filename: "input.yaml",
+			contents: yamlData,
+			errors:   false,
+		},
+		{
+			filename: "input.yml",
+			contents: yamlData,
+			errors:   false,
+		},
+		{
+			filename: "input.toml",
+			contents: tomlData,
+			errors:   false,
+		},
+		{
+			filename: "mismatch.yaml",
+			contents: hclData,
+			errors:   true,
+		},
+		{
+			filename: "mismatch.toml",
+			contents: jsonData,
+			errors:   true,
+		},
 		{
 			filename: "mismatch.tfvars",
 			contents: jsonData,
@@ -76,3 +107,150 @@ func TestMeta_addVarsFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestMeta_addVarsFromFile_yamlAndTOML(t *testing.T) {
+	d := t.TempDir()
+	defer testChdir(t, d)()
+
+	// Each file should produce the same values as this JSON document.
+	jsonData := `{
+  "str": "hello",
+  "num": 12,
+  "big": 123456789012345678901234567890,
+  "float": 1.5,
+  "yes": "yes",
+  "flag": false,
+  "list": ["a", 1, true],
+  "obj": {"nested": {"list": [{"name": "x"}]}},
+  "empty_list": [],
+  "empty_obj": {},
+  "date": "2024-08-01"
+}`
+	yamlData := `
+str: hello
+num: 12
+big: 123456789012345678901234567890
+float: 1.5
+yes: yes
+flag: false
+list: [a, 1, true]
+obj:
+  nested:
+    list:
+      - name: x
+empty_list: []
+empty_obj: {}
+date: 2024-08-01
+`
+	tomlData := `
+str = "hello"
+num = 12
+big = 123456789012345678901234567890.0
+float = 1.5
+yes = "yes"
+flag = false
+list = ["a", 1, true]
+empty_list = []
+date = 2024-08-01
+
+[obj.nested]
+list = [{ name = "x" }]
+
+[empty_obj]
+`
+
+	parse := func(t *testing.T, filename, contents string) map[string]cty.Value {
+		t.Helper()
+		target := filepath.Join(d, filename)
+		if err := os.WriteFile(target, []byte(contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+		m := new(Meta)
+		to := make(map[string]backend.UnparsedVariableValue)
+		diags := m.addVarsFromFile(target, tofu.ValueFromNamedFile, to)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		ret := make(map[string]cty.Value, len(to))
+		for name, raw := range to {
+			v, diags := raw.ParseVariableValue(configs.VariableParseLiteral)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors for %s: %s", name, diags.Err())
+			}
+			ret[name] = v.Value
+		}
+		return ret
+	}
+
+	want := parse(t, "want.json", jsonData)
+	for filename, contents := range map[string]string{
+		"vars.yaml": yamlData,
+		"vars.toml": tomlData,
+	} {
+		t.Run(filename, func(t *testing.T) {
+			got := parse(t, filename, contents)
+			if len(got) != len(want) {
+				t.Errorf("wrong number of variables %d; want %d", len(got), len(want))
+			}
+			for name, wantV := range want {
+				gotV, ok := got[name]
+				if !ok {
+					t.Errorf("missing variable %q", name)
+					continue
+				}
+				if name == "big" && filename == "vars.toml" {
+					// TOML has no arbitrary-precision numbers.
+					continue
+				}
+				if !gotV.RawEquals(wantV) {
+					t.Errorf("wrong value for %q\ngot:  %#v\nwant: %#v", name, gotV, wantV)
+				}
+			}
+		})
+	}
+}
+
+func TestMeta_addVarsFromFile_yamlErrors(t *testing.T) {
+	d := t.TempDir()
+	defer testChdir(t, d)()
+
+	cases := map[string]struct {
+		contents string
+		want     string
+	}{
+		"not a mapping": {
+			"- foo\n- bar\n",
+			"The root of a YAML variables file must be a mapping",
+		},
+		"duplicate": {
+			"foo: 1\nfoo: 2\n",
+			"The variable \"foo\" is set more than once in this file.",
+		},
+		"invalid": {
+			"foo: [\n",
+			"could not be parsed as YAML",
+		},
+		"nan": {
+			"foo: .nan\n",
+			".nan is not a valid number",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			target := filepath.Join(d, "vars.yaml")
+			if err := os.WriteFile(target, []byte(tc.contents), 0600); err != nil {
+				t.Fatal(err)
+			}
+			m := new(Meta)
+			to := make(map[string]backend.UnparsedVariableValue)
+			diags := m.addVarsFromFile(target, tofu.ValueFromNamedFile, to)
+			if !diags.HasErrors() {
+				t.Fatal("succeeded; want error")
+			}
+			if got := diags.Err().Error(); !strings.Contains(got, tc.want) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, tc.want)
+			}
+		})
+	}
+}
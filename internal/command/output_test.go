@@ -88,6 +88,73 @@ func TestOutput_json(t *testing.T) {
 	}
 }
 
+func TestOutput_raw(t *testing.T) {
+	originalState := states.BuildState(func(s *states.SyncState) {
+		for name, val := range map[string]cty.Value{
+			"int":   cty.NumberIntVal(42),
+			"float": cty.NumberFloatVal(0.25),
+			"bool":  cty.False,
+			"null":  cty.NullVal(cty.Number),
+			"list":  cty.ListVal([]cty.Value{cty.StringVal("bar")}),
+		} {
+			s.SetOutputValue(
+				addrs.OutputValue{Name: name}.Absolute(addrs.RootModuleInstance),
+				val,
+				false,
+			)
+		}
+	})
+
+	statePath := testStateFile(t, originalState)
+
+	tests := map[string]struct {
+		want    string
+		wantErr string
+	}{
+		"int":   {want: "42"},
+		"float": {want: "0.25"},
+		"bool":  {want: "false"},
+		"null":  {wantErr: `The value for output value "null" is null`},
+		"list":  {wantErr: "The -raw option only supports strings, numbers, and boolean values"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			view, done := testView(t)
+			c := &OutputCommand{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					View:             view,
+				},
+			}
+
+			code := c.Run([]string{
+				"-state", statePath,
+				"-raw",
+				name,
+			})
+			output := done(t)
+			if test.wantErr != "" {
+				if code != 1 {
+					t.Fatalf("wrong exit code %d; want 1", code)
+				}
+				if got := output.Stdout(); got != "" {
+					t.Errorf("unexpected output: %q", got)
+				}
+				if got := output.Stderr(); !strings.Contains(got, test.wantErr) {
+					t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+				return
+			}
+			if code != 0 {
+				t.Fatalf("bad: \n%s", output.Stderr())
+			}
+			if got := output.Stdout(); got != test.want {
+				t.Errorf("wrong output\ngot:  %q\nwant: %q", got, test.want)
+			}
+		})
+	}
+}
+
 func TestOutput_emptyOutputs(t *testing.T) {
 	originalState := states.NewState()
 	statePath := testStateFile(t, originalState)
@@ -13,9 +13,16 @@ import (
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
+// planUnexpectedChangesExitCode is the exit status of "tofu plan
+// -expect-no-changes" when the plan proposes changes. It is distinct from
+// the status 2 of -detailed-exitcode so that the two can be told apart.
+const planUnexpectedChangesExitCode = 3
+
 // PlanCommand is a Command implementation that compares a OpenTofu
 // configuration to an actual infrastructure and shows the differences.
 type PlanCommand struct {
@@ -68,6 +75,8 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.refreshParallelism = args.Operation.RefreshParallelism
+	c.Meta.noSchemaCache = args.Operation.NoSchemaCache
 
 	diags = diags.Append(c.providerDevOverrideRuntimeWarnings())
 
@@ -114,6 +123,10 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	if op.Result != backend.OperationSuccess {
 		return op.Result.ExitStatus()
 	}
+	if args.ExpectNoChanges && !op.PlanEmpty {
+		view.Diagnostics(tfdiags.Diagnostics(nil).Append(unexpectedChangesError(op.PlanChanges)))
+		return planUnexpectedChangesExitCode
+	}
 	if args.DetailedExitCode && !op.PlanEmpty {
 		return 2
 	}
@@ -121,6 +134,52 @@ func (c *PlanCommand) Run(rawArgs []string) int {
 	return op.Result.ExitStatus()
 }
 
+// unexpectedChangesError returns the error that -expect-no-changes reports
+// for a non-empty plan, listing the address and action of each change.
+func unexpectedChangesError(changes *plans.Changes) tfdiags.Diagnostic {
+	var lines []string
+	if changes != nil {
+		for _, rc := range changes.Resources {
+			if action := unexpectedChangeAction(rc); action != "" {
+				lines = append(lines, fmt.Sprintf("  %s: %s", rc.Addr, action))
+			}
+		}
+		for _, oc := range changes.Outputs {
+			if oc.Addr.Module.IsRoot() && oc.Action != plans.NoOp {
+				lines = append(lines, fmt.Sprintf("  %s: %s", oc.Addr, oc.Action.Verb()))
+			}
+		}
+	}
+
+	detail := "The plan was expected to have no changes"
+	if len(lines) == 0 {
+		// This happens for changes detected only outside of OpenTofu in
+		// refresh-only mode, and for backends that run plans remotely.
+		detail += ", but it does."
+	} else {
+		detail += fmt.Sprintf(", but it proposes the following:\n\n%s", strings.Join(lines, "\n"))
+	}
+	return tfdiags.Sourceless(tfdiags.Error, "Unexpected changes in plan", detail)
+}
+
+func unexpectedChangeAction(rc *plans.ResourceInstanceChangeSrc) string {
+	var parts []string
+	if rc.Action != plans.NoOp {
+		action := rc.Action.Verb()
+		if rc.DeposedKey != states.NotDeposed {
+			action += fmt.Sprintf(" (deposed object %s)", rc.DeposedKey)
+		}
+		parts = append(parts, action)
+	}
+	if rc.Moved() {
+		parts = append(parts, fmt.Sprintf("move from %s", rc.PrevRunAddr))
+	}
+	if rc.Importing != nil {
+		parts = append(parts, "import")
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (c *PlanCommand) PrepareBackend(args *arguments.State, viewType arguments.ViewType, enc encryption.Encryption) (backend.Enhanced, tfdiags.Diagnostics) {
 	// FIXME: we need to apply the state arguments to the meta object here
 	// because they are later used when initializing the backend. Carving a
@@ -167,6 +226,7 @@ func (c *PlanCommand) OperationRequest(
 	opReq.GenerateConfigOut = generateConfigOut
 	opReq.Targets = args.Targets
 	opReq.ForceReplace = args.ForceReplace
+	opReq.ForceReplaceModules = args.ForceReplaceModules
 	opReq.Type = backend.OperationTypePlan
 	opReq.View = view.Operation()
 
@@ -233,6 +293,9 @@ Plan Customization Options:
                       produced an update or no-op action for this instance,
                       OpenTofu will plan to replace it instead. You can use
                       this option multiple times to replace more than one object.
+                      Given a module address, such as module.foo or
+                      module.foo["a"], OpenTofu replaces every managed resource
+                      instance in that module and in its nested modules.
 
   -target=resource    Limit the planning operation to only the given module,
                       resource, or resource instance and all of its
@@ -240,6 +303,10 @@ Plan Customization Options:
                       include more than one object. This is for exceptional
                       use only.
 
+  -target-file=path   Like -target, but reads the addresses from the given
+                      file, one per line. Blank lines and lines starting
+                      with "#" are ignored.
+
   -var 'foo=bar'      Set a value for one of the input variables in the root
                       module of the configuration. Use this option more than
                       once to set more than one variable.
@@ -261,6 +328,10 @@ Other Options:
                              1 - Errored
                              2 - Succeeded, there is a diff
 
+  -expect-no-changes         Fail with exit code 3, listing each planned
+                             change, if the plan isn't empty. An empty plan
+                             still exits with code 0.
+
   -generate-config-out=path  (Experimental) If import blocks are present in
                              configuration, instructs OpenTofu to generate HCL
                              for any imported resources not already present. The
@@ -278,14 +349,24 @@ Other Options:
 
   -no-color                  If specified, output won't contain any color.
 
+  -no-schema-cache           Don't use the on-disk provider schema cache, even
+                             if it's enabled in the CLI configuration.
+
   -concise                   Displays plan output in a concise way, skipping the
-							 refreshing log lines.
+                             refreshing log lines and showing only the
+                             attributes that change.
 
   -out=path                  Write a plan file to the given path. This can be
                              used as input to the "apply" command.
 
   -parallelism=n             Limit the number of concurrent operations. Defaults
-                             to 10.
+                             to 10. Set to 0 to use the number of CPUs, up to
+                             32.
+
+  -refresh-parallelism=n     Limit the number of concurrent operations when
+                             creating a refresh-only plan, which only reads
+                             from providers. Defaults to the -parallelism
+                             value.
 
   -state=statefile           A legacy option used for the local backend only.
                              See the local backend's documentation for more
@@ -1184,6 +1184,62 @@ func TestPlan_detailedExitcode_emptyDiff(t *testing.T) {
 	}
 }
 
+func TestPlan_expectNoChanges(t *testing.T) {
+	t.Run("changes", func(t *testing.T) {
+		td := t.TempDir()
+		testCopyDir(t, testFixturePath("plan"), td)
+		defer testChdir(t, td)()
+
+		p := planFixtureProvider()
+		view, done := testView(t)
+		c := &PlanCommand{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-expect-no-changes", "-detailed-exitcode"})
+		output := done(t)
+		if code != 3 {
+			t.Fatalf("wrong exit status %d; want 3\n\n%s", code, output.Stderr())
+		}
+		got := output.Stderr()
+		for _, want := range []string{
+			"Unexpected changes in plan",
+			"test_instance.foo: create",
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("missing %q in stderr:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		td := t.TempDir()
+		testCopyDir(t, testFixturePath("plan-emptydiff"), td)
+		defer testChdir(t, td)()
+
+		p := testProvider()
+		view, done := testView(t)
+		c := &PlanCommand{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				View:             view,
+			},
+		}
+
+		code := c.Run([]string{"-expect-no-changes"})
+		output := done(t)
+		if code != 0 {
+			t.Fatalf("wrong exit status %d; want 0\n\n%s", code, output.Stderr())
+		}
+		if got := output.Stderr(); got != "" {
+			t.Errorf("unexpected stderr:\n%s", got)
+		}
+	})
+}
+
 func TestPlan_shutdown(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -0,0 +1,318 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/plugin6/convert"
+	"github.com/opentofu/opentofu/internal/providers"
+	proto "github.com/opentofu/opentofu/internal/tfplugin6"
+)
+
+// providerSchemaCacheDirName is the name of the directory under the data
+// directory where provider schemas are cached between runs.
+const providerSchemaCacheDirName = "schemas"
+
+// providerSchemaCacheFormatVersion is recorded in each cache entry so that
+// we can safely ignore entries written by other versions of OpenTofu that
+// used a different format.
+const providerSchemaCacheFormatVersion = 1
+
+// providerSchemaDiskCache stores provider schemas on disk, keyed by provider
+// source address and exact version. Each entry also records the checksums
+// of the provider package from the dependency lock file, so that an entry
+// is ignored if the package changes.
+type providerSchemaDiskCache struct {
+	dir string
+}
+
+// providerSchemaCacheEntry is the JSON structure of a single cache entry.
+// The schema itself is stored as a serialized plugin protocol response,
+// because the protocol already has a complete and stable representation
+// of everything in a provider schema.
+type providerSchemaCacheEntry struct {
+	FormatVersion int      `json:"format_version"`
+	Provider      string   `json:"provider"`
+	Version       string   `json:"version"`
+	Hashes        []string `json:"hashes"`
+	Schema        []byte   `json:"schema"`
+}
+
+// providerSchemaCacheEnabled returns true if OpenTofu should use the on-disk
+// provider schema cache for this run.
+func (m *Meta) providerSchemaCacheEnabled() bool {
+	return m.ProviderSchemaCache && !m.noSchemaCache
+}
+
+func (m *Meta) providerSchemaCache() providerSchemaDiskCache {
+	return providerSchemaDiskCache{
+		dir: filepath.Join(m.DataDir(), providerSchemaCacheDirName),
+	}
+}
+
+func (c providerSchemaDiskCache) entryPath(provider addrs.Provider, version getproviders.Version) string {
+	return filepath.Join(c.dir, provider.Hostname.String(), provider.Namespace, provider.Type, version.String()+".json")
+}
+
+// Load returns the cached schema for the given provider version, if there is
+// one that was recorded with exactly the given package checksums.
+func (c providerSchemaDiskCache) Load(provider addrs.Provider, version getproviders.Version, hashes []getproviders.Hash) (providers.ProviderSchema, bool) {
+	path := c.entryPath(provider, version)
+	src, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] Failed to read cached schema for %s %s: %s", provider, version, err)
+		}
+		return providers.ProviderSchema{}, false
+	}
+
+	var entry providerSchemaCacheEntry
+	if err := json.Unmarshal(src, &entry); err != nil {
+		log.Printf("[WARN] Ignoring invalid cached schema for %s %s: %s", provider, version, err)
+		return providers.ProviderSchema{}, false
+	}
+	if entry.FormatVersion != providerSchemaCacheFormatVersion || entry.Provider != provider.String() || entry.Version != version.String() {
+		log.Printf("[TRACE] Ignoring cached schema for %s %s written in a different format", provider, version)
+		return providers.ProviderSchema{}, false
+	}
+	if !sameProviderHashes(entry.Hashes, hashes) {
+		log.Printf("[TRACE] Ignoring cached schema for %s %s because the package checksums have changed", provider, version)
+		return providers.ProviderSchema{}, false
+	}
+
+	var protoResp proto.GetProviderSchema_Response
+	if err := protobuf.Unmarshal(entry.Schema, &protoResp); err != nil {
+		log.Printf("[WARN] Ignoring invalid cached schema for %s %s: %s", provider, version, err)
+		return providers.ProviderSchema{}, false
+	}
+	schema, err := providerSchemaFromProto(&protoResp)
+	if err != nil {
+		log.Printf("[WARN] Ignoring invalid cached schema for %s %s: %s", provider, version, err)
+		return providers.ProviderSchema{}, false
+	}
+	return schema, true
+}
+
+// Store saves the given schema for the given provider version.
+//
+// The entry is written to a temporary file and then renamed into place, so
+// that concurrent runs sharing the same data directory will only ever see
+// either a complete old entry or a complete new one.
+func (c providerSchemaDiskCache) Store(provider addrs.Provider, version getproviders.Version, hashes []getproviders.Hash, schema providers.ProviderSchema) error {
+	protoResp, err := providerSchemaToProto(schema)
+	if err != nil {
+		return err
+	}
+	raw, err := protobuf.Marshal(protoResp)
+	if err != nil {
+		return err
+	}
+
+	entry := providerSchemaCacheEntry{
+		FormatVersion: providerSchemaCacheFormatVersion,
+		Provider:      provider.String(),
+		Version:       version.String(),
+		Hashes:        sortedProviderHashes(hashes),
+		Schema:        raw,
+	}
+	src, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := c.entryPath(provider, version)
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+	_, err = f.Write(src)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// providerFactoryWithSchemaCache checks the on-disk schema cache for the given
+// provider version. If there's a valid entry then it's added to the global
+// schema cache, so that OpenTofu can avoid asking the provider for its schema
+// again. Otherwise the returned factory saves the schema to the on-disk cache
+// once a provider instance has returned it.
+//
+// Providers whose lock has no checksums are not cached, because there would
+// be no way to notice that the provider package has changed.
+func (m *Meta) providerFactoryWithSchemaCache(provider addrs.Provider, version getproviders.Version, hashes []getproviders.Hash, factory providers.Factory) providers.Factory {
+	if len(hashes) == 0 {
+		return factory
+	}
+
+	cache := m.providerSchemaCache()
+	if schema, ok := cache.Load(provider, version, hashes); ok {
+		log.Printf("[TRACE] Using cached schema for %s %s from %s", provider, version, cache.dir)
+		providers.SchemaCache.Set(provider, schema)
+		return factory
+	}
+
+	return schemaCachingProviderFactory(factory, func(schema providers.ProviderSchema) {
+		if err := cache.Store(provider, version, hashes, schema); err != nil {
+			log.Printf("[WARN] Failed to cache schema for %s %s: %s", provider, version, err)
+		}
+	})
+}
+
+// schemaCachingProviderFactory wraps the given factory so that the first
+// successful GetProviderSchema response from any of its instances is saved
+// using the given store function.
+func schemaCachingProviderFactory(factory providers.Factory, store func(providers.ProviderSchema)) providers.Factory {
+	var once sync.Once
+	return func() (providers.Interface, error) {
+		provider, err := factory()
+		if err != nil {
+			return nil, err
+		}
+		return &schemaCachingProvider{
+			Interface: provider,
+			once:      &once,
+			store:     store,
+		}, nil
+	}
+}
+
+// schemaCachingProvider is a providers.Interface that passes all calls on to
+// the wrapped provider, and saves the schema on the first successful call to
+// GetProviderSchema.
+type schemaCachingProvider struct {
+	providers.Interface
+
+	once  *sync.Once
+	store func(providers.ProviderSchema)
+}
+
+func (p *schemaCachingProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	resp := p.Interface.GetProviderSchema()
+	if !resp.Diagnostics.HasErrors() {
+		p.once.Do(func() {
+			p.store(resp)
+		})
+	}
+	return resp
+}
+
+func providerSchemaToProto(schema providers.ProviderSchema) (resp *proto.GetProviderSchema_Response, err error) {
+	// The conversion functions panic if they encounter types that can't be
+	// serialized, which would only happen for a schema that's invalid anyway.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to serialize schema: %v", r)
+		}
+	}()
+
+	resp = &proto.GetProviderSchema_Response{
+		ResourceSchemas:   make(map[string]*proto.Schema, len(schema.ResourceTypes)),
+		DataSourceSchemas: make(map[string]*proto.Schema, len(schema.DataSources)),
+		Functions:         make(map[string]*proto.Function, len(schema.Functions)),
+		ServerCapabilities: &proto.ServerCapabilities{
+			PlanDestroy:               schema.ServerCapabilities.PlanDestroy,
+			GetProviderSchemaOptional: schema.ServerCapabilities.GetProviderSchemaOptional,
+		},
+	}
+	if schema.Provider.Block != nil {
+		resp.Provider = convert.ProviderSchemaToProto(schema.Provider)
+	}
+	if schema.ProviderMeta.Block != nil {
+		resp.ProviderMeta = convert.ProviderSchemaToProto(schema.ProviderMeta)
+	}
+	for name, s := range schema.ResourceTypes {
+		resp.ResourceSchemas[name] = convert.ProviderSchemaToProto(s)
+	}
+	for name, s := range schema.DataSources {
+		resp.DataSourceSchemas[name] = convert.ProviderSchemaToProto(s)
+	}
+	for name, fn := range schema.Functions {
+		resp.Functions[name] = convert.FunctionSpecToProto(fn)
+	}
+	return resp, nil
+}
+
+func providerSchemaFromProto(protoResp *proto.GetProviderSchema_Response) (schema providers.ProviderSchema, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode schema: %v", r)
+		}
+	}()
+
+	if protoResp.Provider == nil {
+		return schema, fmt.Errorf("missing provider schema")
+	}
+
+	schema.Provider = convert.ProtoToProviderSchema(protoResp.Provider)
+	if protoResp.ProviderMeta != nil {
+		schema.ProviderMeta = convert.ProtoToProviderSchema(protoResp.ProviderMeta)
+	}
+	schema.ResourceTypes = make(map[string]providers.Schema, len(protoResp.ResourceSchemas))
+	for name, s := range protoResp.ResourceSchemas {
+		schema.ResourceTypes[name] = convert.ProtoToProviderSchema(s)
+	}
+	schema.DataSources = make(map[string]providers.Schema, len(protoResp.DataSourceSchemas))
+	for name, s := range protoResp.DataSourceSchemas {
+		schema.DataSources[name] = convert.ProtoToProviderSchema(s)
+	}
+	schema.Functions = make(map[string]providers.FunctionSpec, len(protoResp.Functions))
+	for name, fn := range protoResp.Functions {
+		schema.Functions[name] = convert.ProtoToFunctionSpec(fn)
+	}
+	if protoResp.ServerCapabilities != nil {
+		schema.ServerCapabilities.PlanDestroy = protoResp.ServerCapabilities.PlanDestroy
+		schema.ServerCapabilities.GetProviderSchemaOptional = protoResp.ServerCapabilities.GetProviderSchemaOptional
+	}
+	return schema, nil
+}
+
+func sortedProviderHashes(hashes []getproviders.Hash) []string {
+	ret := make([]string, len(hashes))
+	for i, h := range hashes {
+		ret[i] = h.String()
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func sameProviderHashes(cached []string, hashes []getproviders.Hash) bool {
+	want := sortedProviderHashes(hashes)
+	if len(cached) != len(want) {
+		return false
+	}
+	got := append([]string(nil), cached...)
+	sort.Strings(got)
+	for i := range want {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/getproviders"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/tofu"
+)
+
+func TestProviderSchemaDiskCache(t *testing.T) {
+	cache := providerSchemaDiskCache{dir: t.TempDir()}
+	provider := addrs.NewDefaultProvider("test")
+	version := getproviders.MustParseVersion("1.2.3")
+	hashes := []getproviders.Hash{
+		getproviders.HashScheme1.New("abc"),
+		getproviders.HashSchemeZip.New("def"),
+	}
+	schema := testProviderSchemaForCache()
+
+	if _, ok := cache.Load(provider, version, hashes); ok {
+		t.Fatal("found a cached schema before storing one")
+	}
+
+	if err := cache.Store(provider, version, hashes, schema); err != nil {
+		t.Fatalf("failed to store schema: %s", err)
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		// The order of the hashes doesn't matter.
+		reversed := []getproviders.Hash{hashes[1], hashes[0]}
+		got, ok := cache.Load(provider, version, reversed)
+		if !ok {
+			t.Fatal("cached schema not found")
+		}
+		if diff := cmp.Diff(schema, got, cmp.Comparer(cty.Type.Equals), cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("wrong schema\n%s", diff)
+		}
+	})
+	t.Run("changed hashes", func(t *testing.T) {
+		if _, ok := cache.Load(provider, version, hashes[:1]); ok {
+			t.Error("found a cached schema for different package checksums")
+		}
+	})
+	t.Run("other version", func(t *testing.T) {
+		if _, ok := cache.Load(provider, getproviders.MustParseVersion("1.2.4"), hashes); ok {
+			t.Error("found a cached schema for a different version")
+		}
+	})
+	t.Run("other provider", func(t *testing.T) {
+		if _, ok := cache.Load(addrs.NewDefaultProvider("other"), version, hashes); ok {
+			t.Error("found a cached schema for a different provider")
+		}
+	})
+	t.Run("corrupt entry", func(t *testing.T) {
+		path := cache.entryPath(provider, version)
+		if err := os.WriteFile(path, []byte(`{"format_version":1,`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := cache.Load(provider, version, hashes); ok {
+			t.Error("found a cached schema in a corrupt entry")
+		}
+	})
+}
+
+func TestProviderSchemaDiskCache_noTempFiles(t *testing.T) {
+	cache := providerSchemaDiskCache{dir: t.TempDir()}
+	provider := addrs.NewDefaultProvider("test")
+	version := getproviders.MustParseVersion("1.0.0")
+	hashes := []getproviders.Hash{getproviders.HashScheme1.New("abc")}
+
+	// Storing more than once must replace the entry rather than leaving
+	// any temporary files behind.
+	for i := 0; i < 3; i++ {
+		if err := cache.Store(provider, version, hashes, testProviderSchemaForCache()); err != nil {
+			t.Fatalf("failed to store schema: %s", err)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(cache.entryPath(provider, version)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if diff := cmp.Diff([]string{"1.0.0.json"}, names); diff != "" {
+		t.Errorf("wrong files in cache directory\n%s", diff)
+	}
+}
+
+func TestSchemaCachingProviderFactory(t *testing.T) {
+	schema := testProviderSchemaForCache()
+	var stored []providers.ProviderSchema
+	factory := schemaCachingProviderFactory(
+		func() (providers.Interface, error) {
+			return &tofu.MockProvider{GetProviderSchemaResponse: &schema}, nil
+		},
+		func(s providers.ProviderSchema) {
+			stored = append(stored, s)
+		},
+	)
+
+	for i := 0; i < 2; i++ {
+		p, err := factory()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.GetProviderSchema()
+		p.GetProviderSchema()
+	}
+
+	if len(stored) != 1 {
+		t.Fatalf("schema stored %d times; want 1", len(stored))
+	}
+}
+
+func testProviderSchemaForCache() providers.ProviderSchema {
+	return providers.ProviderSchema{
+		Provider: providers.Schema{
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"region": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceTypes: map[string]providers.Schema{
+			"test_instance": {
+				Version: 2,
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+						"nested": {
+							NestedType: &configschema.Object{
+								Nesting: configschema.NestingList,
+								Attributes: map[string]*configschema.Attribute{
+									"value": {Type: cty.Number, Optional: true, Sensitive: true},
+								},
+							},
+							Optional: true,
+						},
+					},
+					BlockTypes: map[string]*configschema.NestedBlock{
+						"timeouts": {
+							Nesting: configschema.NestingSingle,
+							Block: configschema.Block{
+								Attributes: map[string]*configschema.Attribute{
+									"create": {Type: cty.String, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		DataSources: map[string]providers.Schema{
+			"test_data_source": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id": {Type: cty.String, Required: true},
+					},
+				},
+			},
+		},
+		Functions: map[string]providers.FunctionSpec{
+			"echo": {
+				Parameters: []providers.FunctionParameterSpec{
+					{Name: "input", Type: cty.DynamicPseudoType, DescriptionFormat: providers.TextFormattingPlain},
+				},
+				Return:            cty.DynamicPseudoType,
+				Summary:           "Returns its input",
+				DescriptionFormat: providers.TextFormattingMarkdown,
+			},
+		},
+		ServerCapabilities: providers.ServerCapabilities{
+			GetProviderSchemaOptional: true,
+		},
+	}
+}
@@ -10,7 +10,10 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/apparentlymart/go-versions/versions"
 	"github.com/hashicorp/go-getter"
@@ -58,12 +61,28 @@ func (c *ProvidersMirrorCommand) Run(args []string) int {
 	}
 	outputDir := args[0]
 
+	// Platforms given literally are requested for every provider, while
+	// glob patterns are expanded separately for each provider against the
+	// platforms its origin registry actually advertises.
 	var platforms []getproviders.Platform
+	var platformPatterns []string
 	if len(optPlatforms) == 0 {
 		platforms = []getproviders.Platform{getproviders.CurrentPlatform}
 	} else {
 		platforms = make([]getproviders.Platform, 0, len(optPlatforms))
 		for _, platformStr := range optPlatforms {
+			if isPlatformPattern(platformStr) {
+				if _, err := path.Match(platformStr, ""); err != nil {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Invalid target platform",
+						fmt.Sprintf("The string %q given in the -platform option is not a valid target platform pattern: %s.", platformStr, err),
+					))
+					continue
+				}
+				platformPatterns = append(platformPatterns, platformStr)
+				continue
+			}
 			platform, err := getproviders.ParsePlatform(platformStr)
 			if err != nil {
 				diags = diags.Append(tfdiags.Sourceless(
@@ -111,9 +130,8 @@ func (c *ProvidersMirrorCommand) Run(args []string) int {
 	// for every provider so that it can be used to update a local mirror
 	// directory without needing to first disable that local mirror
 	// in the CLI configuration.
-	source := getproviders.NewMemoizeSource(
-		getproviders.NewRegistrySource(c.Services),
-	)
+	registrySource := getproviders.NewRegistrySource(c.Services)
+	source := getproviders.NewMemoizeSource(registrySource)
 
 	// Providers from registries always use HTTP, so we don't need the full
 	// generality of go-getter but it's still handy to use the HTTP getter
@@ -171,7 +189,28 @@ func (c *ProvidersMirrorCommand) Run(args []string) int {
 		} else {
 			c.Ui.Output(fmt.Sprintf("  - Selected v%s with no constraints", selected.String()))
 		}
-		for _, platform := range platforms {
+		providerPlatforms := platforms
+		if len(platformPatterns) != 0 {
+			available, err := registrySource.AvailablePlatforms(ctx, provider, selected)
+			if err != nil {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Provider platforms not available",
+					fmt.Sprintf("Failed to find the platforms that %s v%s is available for: %s.", provider.String(), selected.String(), err),
+				))
+				continue
+			}
+			var unmatched []string
+			providerPlatforms, unmatched = expandPlatformPatterns(platforms, platformPatterns, available)
+			for _, pattern := range unmatched {
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					"No matching target platforms",
+					fmt.Sprintf("%s v%s is not available for any platform matching %q, so no packages were mirrored for that pattern.", provider.String(), selected.String(), pattern),
+				))
+			}
+		}
+		for _, platform := range providerPlatforms {
 			c.Ui.Output(fmt.Sprintf("  - Downloading package for %s...", platform.String()))
 			meta, err := source.PackageMeta(ctx, provider, selected, platform)
 			if err != nil {
@@ -354,6 +393,54 @@ func (c *ProvidersMirrorCommand) Run(args []string) int {
 	return 0
 }
 
+// isPlatformPattern returns true if the given -platform argument uses any of
+// the glob metacharacters understood by path.Match, and so must be expanded
+// against the platforms a provider is available for.
+func isPlatformPattern(s string) bool {
+	return strings.ContainsAny(s, "*?[\\")
+}
+
+// expandPlatformPatterns returns the given explicit platforms followed by
+// each of the available platforms that matches at least one of the given
+// glob patterns, without duplicates. It also returns any patterns that
+// didn't match any of the available platforms.
+func expandPlatformPatterns(explicit []getproviders.Platform, patterns []string, available []getproviders.Platform) ([]getproviders.Platform, []string) {
+	seen := make(map[getproviders.Platform]struct{}, len(explicit)+len(available))
+	ret := make([]getproviders.Platform, 0, len(explicit)+len(available))
+	for _, platform := range explicit {
+		if _, exists := seen[platform]; !exists {
+			seen[platform] = struct{}{}
+			ret = append(ret, platform)
+		}
+	}
+
+	var matched []getproviders.Platform
+	var unmatched []string
+	for _, pattern := range patterns {
+		found := false
+		for _, platform := range available {
+			// The patterns were already validated by the caller, so we can
+			// safely ignore the error here.
+			if ok, _ := path.Match(pattern, platform.String()); !ok {
+				continue
+			}
+			found = true
+			if _, exists := seen[platform]; !exists {
+				seen[platform] = struct{}{}
+				matched = append(matched, platform)
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, pattern)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].LessThan(matched[j])
+	})
+	return append(ret, matched...), unmatched
+}
+
 func (c *ProvidersMirrorCommand) Help() string {
 	return `
 Usage: tofu [global options] providers mirror [options] <target-dir>
@@ -382,6 +469,11 @@ Options:
                      CPU. Each provider is available only for a limited
                      set of target platforms.
 
+                     Target names may also be glob patterns, such as
+                     "linux_*" or "*_arm64", which select all of the
+                     matching platforms that each provider is available
+                     for in its origin registry.
+
   -var 'foo=bar'     Set a value for one of the input variables in the root
                      module of the configuration. Use this option more than
                      once to set more than one variable.
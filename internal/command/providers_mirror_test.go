@@ -9,7 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/mitchellh/cli"
+
+	"github.com/opentofu/opentofu/internal/getproviders"
 )
 
 // More thorough tests for providers mirror can be found in the e2etest
@@ -38,4 +41,89 @@ func TestProvidersMirror(t *testing.T) {
 			t.Fatalf("missing directory error from output, got:\n%s\n", got)
 		}
 	})
+
+	t.Run("invalid platform pattern error", func(t *testing.T) {
+		ui := new(cli.MockUi)
+		c := &ProvidersMirrorCommand{
+			Meta: Meta{Ui: ui},
+		}
+		code := c.Run([]string{"-platform=linux_[", "."})
+		if code != 1 {
+			t.Fatalf("wrong exit code. expected 1, got %d", code)
+		}
+
+		got := ui.ErrorWriter.String()
+		if !strings.Contains(got, "Error: Invalid target platform") {
+			t.Fatalf("missing platform error from output, got:\n%s\n", got)
+		}
+	})
+}
+
+func TestExpandPlatformPatterns(t *testing.T) {
+	available := []getproviders.Platform{
+		{OS: "windows", Arch: "amd64"},
+		{OS: "linux", Arch: "arm64"},
+		{OS: "darwin", Arch: "arm64"},
+		{OS: "linux", Arch: "amd64"},
+	}
+
+	tests := map[string]struct {
+		explicit      []getproviders.Platform
+		patterns      []string
+		wantPlatforms []getproviders.Platform
+		wantUnmatched []string
+	}{
+		"os wildcard": {
+			nil,
+			[]string{"linux_*"},
+			[]getproviders.Platform{
+				{OS: "linux", Arch: "amd64"},
+				{OS: "linux", Arch: "arm64"},
+			},
+			nil,
+		},
+		"arch wildcard": {
+			nil,
+			[]string{"*_arm64"},
+			[]getproviders.Platform{
+				{OS: "darwin", Arch: "arm64"},
+				{OS: "linux", Arch: "arm64"},
+			},
+			nil,
+		},
+		"overlapping patterns and explicit platforms": {
+			[]getproviders.Platform{
+				{OS: "linux", Arch: "arm64"},
+				{OS: "freebsd", Arch: "amd64"},
+			},
+			[]string{"linux_*", "*_arm64"},
+			[]getproviders.Platform{
+				{OS: "linux", Arch: "arm64"},
+				{OS: "freebsd", Arch: "amd64"},
+				{OS: "darwin", Arch: "arm64"},
+				{OS: "linux", Arch: "amd64"},
+			},
+			nil,
+		},
+		"unmatched pattern": {
+			nil,
+			[]string{"openbsd_*", "windows_*"},
+			[]getproviders.Platform{
+				{OS: "windows", Arch: "amd64"},
+			},
+			[]string{"openbsd_*"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotPlatforms, gotUnmatched := expandPlatformPatterns(test.explicit, test.patterns, available)
+			if diff := cmp.Diff(test.wantPlatforms, gotPlatforms); diff != "" {
+				t.Errorf("wrong platforms\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantUnmatched, gotUnmatched); diff != "" {
+				t.Errorf("wrong unmatched patterns\n%s", diff)
+			}
+		})
+	}
 }
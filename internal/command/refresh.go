@@ -68,6 +68,8 @@ func (c *RefreshCommand) Run(rawArgs []string) int {
 	// clear path to pass this value down, so we continue to mutate the Meta
 	// object state for now.
 	c.Meta.parallelism = args.Operation.Parallelism
+	c.Meta.refreshParallelism = args.Operation.RefreshParallelism
+	c.Meta.noSchemaCache = args.Operation.NoSchemaCache
 
 	// Inject variables from args into meta for static evaluation
 	c.GatherVariables(args.Vars)
@@ -207,12 +209,24 @@ Options:
 
   -no-color           If specified, output won't contain any color.
 
+  -no-schema-cache    Don't use the on-disk provider schema cache, even if
+                      it's enabled in the CLI configuration.
+
   -parallelism=n      Limit the number of concurrent operations. Defaults to 10.
+                      Set to 0 to use the number of CPUs, up to 32.
+
+  -refresh-parallelism=n
+                      Limit the number of concurrent refresh operations
+                      separately from -parallelism. Defaults to the
+                      -parallelism value.
 
   -target=resource    Resource to target. Operation will be limited to this
                       resource and its dependencies. This flag can be used
                       multiple times.
 
+  -target-file=path   Like -target, but reads the resource addresses from
+                      the given file, one per line.
+
   -var 'foo=bar'      Set a variable in the OpenTofu configuration. This
                       flag can be set multiple times.
 
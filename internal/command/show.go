@@ -6,9 +6,12 @@
 package command
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -54,9 +57,14 @@ func (e *errUnusableDataMisc) Unwrap() error {
 type ShowCommand struct {
 	Meta
 	viewType arguments.ViewType
+	input    io.Reader // STDIN if nil
 }
 
 func (c *ShowCommand) Run(rawArgs []string) int {
+	if c.input == nil {
+		c.input = os.Stdin
+	}
+
 	// Parse and apply global view arguments
 	common, rawArgs := arguments.ParseView(rawArgs)
 	c.View.Configure(common)
@@ -110,7 +118,8 @@ func (c *ShowCommand) Help() string {
 Usage: tofu [global options] show [options] [path]
 
   Reads and outputs a OpenTofu state or plan file in a human-readable
-  form. If no path is specified, the current state will be shown.
+  form. If no path is specified, the current state will be shown. If the
+  path is "-" then the state or plan file is read from standard input.
 
 Options:
 
@@ -121,6 +130,9 @@ Options:
 
   -show-sensitive     If specified, sensitive values will be displayed.
 
+  -concise            When showing a plan, display only the attributes that
+                      change.
+
   -var 'foo=bar'      Set a value for one of the input variables in the root
                       module of the configuration. Use this option more than
                       once to set more than one variable.
@@ -173,10 +185,20 @@ func (c *ShowCommand) show(path string, enc encryption.Encryption) (*plans.Plan,
 		}
 	}
 
+	// The user asked us to read from stdin, so we'll decide which kind of
+	// file it is from its content since there's no file to try opening.
+	if path == stdinArg {
+		plan, jsonPlan, stateFile, config, showDiags = c.showFromStdin(enc)
+		diags = diags.Append(showDiags)
+		if showDiags.HasErrors() {
+			return plan, jsonPlan, stateFile, config, schemas, diags
+		}
+	}
+
 	// Plan file or state file argument provided,
 	// so try to load the argument as a plan file first.
 	// If that fails, try to load it as a statefile.
-	if path != "" {
+	if path != "" && path != stdinArg {
 		plan, jsonPlan, stateFile, config, showDiags = c.showFromPath(path, enc)
 		diags = diags.Append(showDiags)
 		if showDiags.HasErrors() {
@@ -310,6 +332,153 @@ func (c *ShowCommand) showFromPath(path string, enc encryption.Encryption) (*pla
 	return plan, jsonPlan, stateFile, config, diags
 }
 
+// showStdinKind is the kind of file that showFromStdin detected on stdin.
+type showStdinKind int
+
+const (
+	showStdinUnknown showStdinKind = iota
+	showStdinPlan
+	showStdinState
+)
+
+// zipMagic is the local file header signature that every local plan file
+// begins with, because plan files are zip archives.
+var zipMagic = []byte("PK\x03\x04")
+
+func (c *ShowCommand) showFromStdin(enc encryption.Encryption) (*plans.Plan, *cloudplan.RemotePlanJSON, *statefile.File, *configs.Config, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	raw, err := io.ReadAll(c.input)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read standard input",
+			fmt.Sprintf("Couldn't read a state or plan file from standard input: %s.", err),
+		))
+		return nil, nil, nil, nil, diags
+	}
+
+	kind, err := detectShowStdinKind(raw, enc)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read standard input as a state or plan file",
+			fmt.Sprintf("Couldn't determine the kind of file given on standard input: %s.", err),
+		))
+		return nil, nil, nil, nil, diags
+	}
+
+	switch kind {
+	case showStdinState:
+		stateFile, err := statefile.Read(bytes.NewReader(raw), enc.State())
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Couldn't show state file",
+				fmt.Sprintf("State read error: %s", err),
+			))
+			return nil, nil, nil, nil, diags
+		}
+		return nil, nil, stateFile, nil, diags
+
+	default:
+		rootCall, callDiags := c.rootModuleCall(".")
+		diags = diags.Append(callDiags)
+		if diags.HasErrors() {
+			return nil, nil, nil, nil, diags
+		}
+
+		// The plan file readers only work with paths, so we'll spool the
+		// plan to a temporary file. The readers load the whole file into
+		// memory, so we can remove it again as soon as we're done here.
+		tmpFile, err := os.CreateTemp("", "tofu-show-")
+		if err == nil {
+			defer os.Remove(tmpFile.Name())
+			_, err = tmpFile.Write(raw)
+			if closeErr := tmpFile.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to read standard input",
+				fmt.Sprintf("Couldn't create a temporary file for the plan read from standard input: %s.", err),
+			))
+			return nil, nil, nil, nil, diags
+		}
+
+		plan, jsonPlan, stateFile, config, err := c.getPlanFromPath(tmpFile.Name(), enc, rootCall)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Couldn't show plan",
+				fmt.Sprintf("Plan read error: %s", err),
+			))
+			return nil, nil, nil, nil, diags
+		}
+		return plan, jsonPlan, stateFile, config, diags
+	}
+}
+
+// detectShowStdinKind decides whether the given bytes are a plan file or a
+// state snapshot. A local plan file is always a zip archive, while a state
+// snapshot and a saved cloud plan are both JSON objects with distinct
+// top-level properties. Encrypted payloads look the same whether they
+// contain a plan or a state, so those are identified by which of the
+// configured encryption targets can decrypt them.
+//
+// An error is returned if the content matches neither kind or could be
+// either of them, because guessing wrong would produce a confusing error
+// from the wrong reader.
+func detectShowStdinKind(raw []byte, enc encryption.Encryption) (showStdinKind, error) {
+	if len(raw) == 0 {
+		return showStdinUnknown, fmt.Errorf("no data was received")
+	}
+	if bytes.HasPrefix(raw, zipMagic) {
+		return showStdinPlan, nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return showStdinUnknown, fmt.Errorf("the data is neither a plan file nor a JSON state snapshot")
+	}
+
+	if encrypted, _ := encryption.IsEncryptionPayload(raw); encrypted {
+		// A target with encryption disabled returns the payload unchanged,
+		// so we also check that what we got back is no longer encrypted.
+		planData, planErr := enc.Plan().DecryptPlan(raw)
+		isPlan := planErr == nil && bytes.HasPrefix(planData, zipMagic)
+		stateData, stateErr := enc.State().DecryptState(raw)
+		isState := stateErr == nil
+		if isState {
+			stillEncrypted, _ := encryption.IsEncryptionPayload(stateData)
+			isState = !stillEncrypted
+		}
+		switch {
+		case isPlan && !isState:
+			return showStdinPlan, nil
+		case isState && !isPlan:
+			return showStdinState, nil
+		case isPlan && isState:
+			return showStdinUnknown, fmt.Errorf("the encrypted data can be decrypted as both a plan file and a state snapshot")
+		default:
+			return showStdinUnknown, fmt.Errorf("the data is encrypted and couldn't be decrypted as either a plan file or a state snapshot")
+		}
+	}
+
+	_, hasLineage := obj["lineage"]
+	_, hasPlanFormat := obj["remote_plan_format"]
+	switch {
+	case hasPlanFormat && !hasLineage:
+		return showStdinPlan, nil
+	case hasLineage && !hasPlanFormat:
+		return showStdinState, nil
+	default:
+		return showStdinUnknown, fmt.Errorf("the JSON data is neither a state snapshot nor a saved cloud plan")
+	}
+}
+
 // getPlanFromPath returns a plan, json plan, statefile, and config if the
 // user-supplied path points to either a local or cloud plan file. Note that
 // some of the return values will be nil no matter what; local plan files do not
@@ -6,7 +6,9 @@
 package command
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -527,6 +529,113 @@ func TestShow_state(t *testing.T) {
 	}
 }
 
+func TestShow_stdinState(t *testing.T) {
+	statePath := testStateFile(t, testState())
+	stateBytes, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, jsonOutput := range []bool{false, true} {
+		t.Run(fmt.Sprintf("json=%t", jsonOutput), func(t *testing.T) {
+			view, done := testView(t)
+			c := &ShowCommand{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(showFixtureProvider()),
+					View:             view,
+				},
+				input: bytes.NewReader(stateBytes),
+			}
+
+			args := []string{"-no-color", "-"}
+			want := `# test_instance.foo:`
+			if jsonOutput {
+				args = append([]string{"-json"}, args...)
+				want = `"address":"test_instance.foo"`
+			}
+			code := c.Run(args)
+			output := done(t)
+
+			if code != 0 {
+				t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+			}
+			if got := output.Stdout(); !strings.Contains(got, want) {
+				t.Errorf("unexpected output\ngot: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestShow_stdinPlan(t *testing.T) {
+	planPath := testPlanFileNoop(t)
+	planBytes, err := os.ReadFile(planPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, jsonOutput := range []bool{false, true} {
+		t.Run(fmt.Sprintf("json=%t", jsonOutput), func(t *testing.T) {
+			view, done := testView(t)
+			c := &ShowCommand{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					View:             view,
+				},
+				input: bytes.NewReader(planBytes),
+			}
+
+			args := []string{"-no-color", "-"}
+			want := `No changes. Your infrastructure matches the configuration.`
+			if jsonOutput {
+				args = append([]string{"-json"}, args...)
+				want = `"planned_values"`
+			}
+			code := c.Run(args)
+			output := done(t)
+
+			if code != 0 {
+				t.Fatalf("unexpected exit status %d; want 0\ngot: %s", code, output.Stderr())
+			}
+			if got := output.Stdout(); !strings.Contains(got, want) {
+				t.Errorf("unexpected output\ngot: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestShow_stdinUnrecognized(t *testing.T) {
+	tests := map[string]string{
+		"empty":    ``,
+		"not json": `hello`,
+		"both":     `{"lineage":"abc","remote_plan_format":1}`,
+		"neither":  `{"foo":"bar"}`,
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			view, done := testView(t)
+			c := &ShowCommand{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					View:             view,
+				},
+				input: strings.NewReader(input),
+			}
+
+			code := c.Run([]string{"-no-color", "-"})
+			output := done(t)
+
+			if code != 1 {
+				t.Fatalf("unexpected exit status %d; want 1\ngot: %s", code, output.Stdout())
+			}
+			want := `Failed to read standard input as a state or plan file`
+			if got := output.Stderr(); !strings.Contains(got, want) {
+				t.Errorf("unexpected output\ngot: %s\nwant: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestShow_json_output(t *testing.T) {
 	fixtureDir := "testdata/show-json"
 	testDirs, err := os.ReadDir(fixtureDir)
@@ -0,0 +1,400 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/cli"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/command/format"
+	"github.com/opentofu/opentofu/internal/command/jsonformat/computed"
+	"github.com/opentofu/opentofu/internal/command/jsonformat/differ"
+	"github.com/opentofu/opentofu/internal/command/jsonformat/structured"
+	"github.com/opentofu/opentofu/internal/command/jsonformat/structured/attribute_path"
+	"github.com/opentofu/opentofu/internal/command/jsonstate"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+// StateDiffCommand is a Command implementation that compares two state files
+// without consulting the configuration or any providers.
+type StateDiffCommand struct {
+	Meta
+	StateMeta
+}
+
+func (c *StateDiffCommand) Run(args []string) int {
+	args = c.Meta.process(args)
+	cmdFlags := c.Meta.defaultFlagSet("state diff")
+	var jsonOutput, showSensitive bool
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "produce JSON output")
+	cmdFlags.BoolVar(&showSensitive, "show-sensitive", false, "displays sensitive values")
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Streams.Eprintf("Error parsing command-line flags: %s\n", err.Error())
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Streams.Eprint("Exactly two arguments expected: the old and new state files.\n")
+		return cli.RunResultHelp
+	}
+
+	// The state files may be encrypted, so we still need the encryption
+	// configuration from the current working directory.
+	enc, encDiags := c.Encryption()
+	if encDiags.HasErrors() {
+		c.showDiagnostics(encDiags)
+		return 1
+	}
+
+	oldFile, err := getStateFromPath(args[0], enc)
+	if err != nil {
+		c.Streams.Eprintln(err)
+		return 1
+	}
+	newFile, err := getStateFromPath(args[1], enc)
+	if err != nil {
+		c.Streams.Eprintln(err)
+		return 1
+	}
+
+	diffs, err := diffStates(oldFile.State, newFile.State)
+	if err != nil {
+		c.Streams.Eprintf("Failed to compare states: %s\n", err)
+		return 1
+	}
+
+	if jsonOutput {
+		out, err := marshalStateDiffs(diffs)
+		if err != nil {
+			c.Streams.Eprintf("Failed to marshal state diff to JSON: %s\n", err)
+			return 1
+		}
+		c.Streams.Println(string(out))
+		return 0
+	}
+
+	if len(diffs) == 0 {
+		c.Streams.Println("No differences found; the two states contain the same resource instances.")
+		return 0
+	}
+	c.Streams.Print(c.renderStateDiffs(diffs, showSensitive))
+	return 0
+}
+
+// renderStateDiffs renders the differences between two states in the same
+// style as the changes in a plan. We have no schemas, so each object is
+// rendered as the generic value it was decoded as.
+func (c *StateDiffCommand) renderStateDiffs(diffs []stateInstanceDiff, showSensitive bool) string {
+	colorize := c.Colorize()
+	opts := computed.NewRenderHumanOpts(colorize, showSensitive)
+	// Like the resources in a plan, removed objects are shown without the
+	// "-> null" suffix.
+	opts.OverrideNullSuffix = true
+
+	var buf bytes.Buffer
+	var added, removed, changed int
+	for _, d := range diffs {
+		var verb string
+		switch d.Action {
+		case plans.Create:
+			verb = "was added"
+			added++
+		case plans.Delete:
+			verb = "was removed"
+			removed++
+		default:
+			verb = "has changed"
+			changed++
+		}
+		change := structured.Change{
+			Before:             d.Before,
+			After:              d.After,
+			Unknown:            false,
+			BeforeSensitive:    d.BeforeSensitive,
+			AfterSensitive:     d.AfterSensitive,
+			ReplacePaths:       attribute_path.Empty(false),
+			RelevantAttributes: attribute_path.AlwaysMatcher(),
+		}
+		mode := "resource"
+		if d.Addr.Resource.Resource.Mode == addrs.DataResourceMode {
+			mode = "data"
+		}
+		fmt.Fprintf(&buf, "%s\n", colorize.Color(fmt.Sprintf("[bold]  # %s[reset] %s", d.displayAddr(), verb)))
+		fmt.Fprintf(&buf, "%s %s %q %q %s\n\n",
+			colorize.Color(format.DiffActionSymbol(d.Action)),
+			mode,
+			d.Addr.Resource.Resource.Type,
+			d.Addr.Resource.Resource.Name,
+			differ.ComputeDiffForOutput(change).RenderHuman(0, opts),
+		)
+	}
+	fmt.Fprintf(&buf, "%s %d added, %d changed, %d removed.\n", colorize.Color("[bold]Summary:[reset]"), added, changed, removed)
+	return buf.String()
+}
+
+func (c *StateDiffCommand) Help() string {
+	helpText := `
+Usage: tofu [global options] state diff [options] OLD_STATE NEW_STATE
+
+  Compares two state files and shows the resource instances that were
+  added, removed, or changed between them, along with the attributes that
+  differ.
+
+  This command reads only the two given files. It doesn't use the current
+  backend or configuration, except for the encryption configuration
+  needed to read encrypted state files, and it makes no provider calls.
+
+Options:
+
+  -json               Produce the differences in a machine-readable JSON
+                      format.
+
+  -show-sensitive     If specified, sensitive values will be displayed.
+                      JSON output always includes sensitive values.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDiffCommand) Synopsis() string {
+	return "Compare two state files"
+}
+
+// stateInstanceDiff describes the difference in a single resource instance
+// object between two states.
+type stateInstanceDiff struct {
+	Addr    addrs.AbsResourceInstance
+	Deposed states.DeposedKey
+	Action  plans.Action
+
+	// Before and After are the decoded JSON attributes of the object, or nil
+	// if the object doesn't exist in that state. BeforeSensitive and
+	// AfterSensitive have the same shape as the values, with true for each
+	// value that is sensitive.
+	Before, After                   interface{}
+	BeforeSensitive, AfterSensitive interface{}
+}
+
+func (d stateInstanceDiff) displayAddr() string {
+	if d.Deposed != states.NotDeposed {
+		return fmt.Sprintf("%s (deposed object %s)", d.Addr, d.Deposed)
+	}
+	return d.Addr.String()
+}
+
+type stateDiffObject struct {
+	addr    addrs.AbsResourceInstance
+	deposed states.DeposedKey
+	obj     *states.ResourceInstanceObjectSrc
+}
+
+func (o stateDiffObject) key() string {
+	if o.deposed != states.NotDeposed {
+		return o.addr.String() + " " + string(o.deposed)
+	}
+	return o.addr.String()
+}
+
+// diffStates compares each of the resource instance objects in the two
+// states, returning the differences ordered by address.
+func diffStates(oldState, newState *states.State) ([]stateInstanceDiff, error) {
+	oldObjs := stateDiffObjects(oldState)
+	newObjs := stateDiffObjects(newState)
+
+	keys := make(map[string]addrs.AbsResourceInstance)
+	for k, o := range oldObjs {
+		keys[k] = o.addr
+	}
+	for k, o := range newObjs {
+		keys[k] = o.addr
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := keys[sorted[i]], keys[sorted[j]]
+		if !a.Equal(b) {
+			return a.Less(b)
+		}
+		// The current object sorts before the deposed objects, which are
+		// ordered by their keys.
+		return sorted[i] < sorted[j]
+	})
+
+	var ret []stateInstanceDiff
+	for _, k := range sorted {
+		oldObj, hasOld := oldObjs[k]
+		newObj, hasNew := newObjs[k]
+
+		d := stateInstanceDiff{Addr: keys[k], BeforeSensitive: false, AfterSensitive: false}
+		if hasOld {
+			d.Deposed = oldObj.deposed
+			val, sensitive, err := decodeStateDiffObject(oldObj.obj)
+			if err != nil {
+				return nil, fmt.Errorf("%s in the old state: %w", oldObj.addr, err)
+			}
+			d.Before, d.BeforeSensitive = val, sensitive
+		}
+		if hasNew {
+			d.Deposed = newObj.deposed
+			val, sensitive, err := decodeStateDiffObject(newObj.obj)
+			if err != nil {
+				return nil, fmt.Errorf("%s in the new state: %w", newObj.addr, err)
+			}
+			d.After, d.AfterSensitive = val, sensitive
+		}
+
+		switch {
+		case !hasOld:
+			d.Action = plans.Create
+		case !hasNew:
+			d.Action = plans.Delete
+		case reflect.DeepEqual(d.Before, d.After) && reflect.DeepEqual(d.BeforeSensitive, d.AfterSensitive):
+			continue
+		default:
+			d.Action = plans.Update
+		}
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
+
+func stateDiffObjects(state *states.State) map[string]stateDiffObject {
+	ret := make(map[string]stateDiffObject)
+	if state == nil {
+		return ret
+	}
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for key, is := range rs.Instances {
+				addr := rs.Addr.Instance(key)
+				if is.Current != nil {
+					o := stateDiffObject{addr: addr, deposed: states.NotDeposed, obj: is.Current}
+					ret[o.key()] = o
+				}
+				for dk, obj := range is.Deposed {
+					o := stateDiffObject{addr: addr, deposed: dk, obj: obj}
+					ret[o.key()] = o
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// decodeStateDiffObject decodes the attributes of a resource instance object
+// as generic JSON values, along with a matching structure marking the values
+// that are sensitive. Without the schema, the type of the object is implied
+// from its JSON representation.
+func decodeStateDiffObject(obj *states.ResourceInstanceObjectSrc) (interface{}, interface{}, error) {
+	src := obj.AttrsJSON
+	if src == nil {
+		// Objects from very old state files that were never upgraded only
+		// have flatmap attributes, which we show as a flat map of strings.
+		flat, err := json.Marshal(obj.AttrsFlat)
+		if err != nil {
+			return nil, nil, err
+		}
+		src = flat
+	}
+
+	ty, err := ctyjson.ImpliedType(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	val, err := ctyjson.Unmarshal(src, ty)
+	if err != nil {
+		return nil, nil, err
+	}
+	sensitive := jsonstate.SensitiveAsBoolWithPathValueMarks(val, obj.AttrSensitivePaths)
+	sensitiveSrc, err := ctyjson.Marshal(sensitive, sensitive.Type())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attrs, err := decodeStateDiffJSON(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	sensitiveAttrs, err := decodeStateDiffJSON(sensitiveSrc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attrs, sensitiveAttrs, nil
+}
+
+// decodeStateDiffJSON decodes JSON as generic values in the way the plan
+// renderer expects, with numbers kept as json.Number.
+func decodeStateDiffJSON(src []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	var ret interface{}
+	if err := dec.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// stateDiffJSON is the JSON representation of a stateInstanceDiff.
+type stateDiffJSON struct {
+	Address         string          `json:"address"`
+	Deposed         string          `json:"deposed,omitempty"`
+	Action          string          `json:"action"`
+	Before          json.RawMessage `json:"before"`
+	After           json.RawMessage `json:"after"`
+	BeforeSensitive json.RawMessage `json:"before_sensitive"`
+	AfterSensitive  json.RawMessage `json:"after_sensitive"`
+}
+
+func marshalStateDiffs(diffs []stateInstanceDiff) ([]byte, error) {
+	ret := struct {
+		Changes []stateDiffJSON `json:"resource_changes"`
+	}{
+		Changes: make([]stateDiffJSON, 0, len(diffs)),
+	}
+	for _, d := range diffs {
+		var action string
+		switch d.Action {
+		case plans.Create:
+			action = "create"
+		case plans.Delete:
+			action = "delete"
+		default:
+			action = "update"
+		}
+		j := stateDiffJSON{
+			Address: d.Addr.String(),
+			Deposed: string(d.Deposed),
+			Action:  action,
+		}
+		var err error
+		for _, f := range []struct {
+			dst *json.RawMessage
+			val interface{}
+		}{
+			{&j.Before, d.Before},
+			{&j.After, d.After},
+			{&j.BeforeSensitive, d.BeforeSensitive},
+			{&j.AfterSensitive, d.AfterSensitive},
+		} {
+			if *f.dst, err = json.Marshal(f.val); err != nil {
+				return nil, err
+			}
+		}
+		ret.Changes = append(ret.Changes, j)
+	}
+	return json.Marshal(ret)
+}
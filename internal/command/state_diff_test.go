@@ -0,0 +1,209 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/lang/marks"
+	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/terminal"
+)
+
+func TestStateDiff(t *testing.T) {
+	oldPath, newPath := testStateDiffFiles(t)
+
+	streams, done := terminal.StreamsForTesting(t)
+	c := &StateDiffCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Streams:          streams,
+		},
+	}
+	if code := c.Run([]string{"-no-color", oldPath, newPath}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, done(t).Stderr())
+	}
+
+	got := done(t).Stdout()
+	want := `  # test_instance.added["a"] was added
+  + resource "test_instance" "added" {
+      + id   = "added"
+      + tags = [
+          + "x",
+        ]
+    }
+
+  # test_instance.changed has changed
+  ~ resource "test_instance" "changed" {
+        id       = "changed"
+      ~ password = (sensitive value)
+      ~ size     = 1 -> 2
+    }
+
+  # test_instance.removed[0] was removed
+  - resource "test_instance" "removed" {
+      - id = "removed"
+    }
+
+Summary: 1 added, 1 changed, 1 removed.
+`
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
+
+func TestStateDiff_showSensitive(t *testing.T) {
+	oldPath, newPath := testStateDiffFiles(t)
+
+	streams, done := terminal.StreamsForTesting(t)
+	c := &StateDiffCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Streams:          streams,
+		},
+	}
+	if code := c.Run([]string{"-no-color", "-show-sensitive", oldPath, newPath}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, done(t).Stderr())
+	}
+
+	got := done(t).Stdout()
+	if want := `~ password = (sensitive value)`; strings.Contains(got, want) {
+		t.Errorf("sensitive value was redacted\n%s", got)
+	}
+	if want := `"old-secret" -> "new-secret"`; !strings.Contains(got, want) {
+		t.Errorf("missing %q in output\n%s", want, got)
+	}
+}
+
+func TestStateDiff_json(t *testing.T) {
+	oldPath, newPath := testStateDiffFiles(t)
+
+	streams, done := terminal.StreamsForTesting(t)
+	c := &StateDiffCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Streams:          streams,
+		},
+	}
+	if code := c.Run([]string{"-json", oldPath, newPath}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, done(t).Stderr())
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(done(t).Stdout()), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"resource_changes": []interface{}{
+			map[string]interface{}{
+				"address":          `test_instance.added["a"]`,
+				"action":           "create",
+				"before":           nil,
+				"after":            map[string]interface{}{"id": "added", "tags": []interface{}{"x"}},
+				"before_sensitive": false,
+				"after_sensitive":  map[string]interface{}{"tags": []interface{}{false}},
+			},
+			map[string]interface{}{
+				"address":          "test_instance.changed",
+				"action":           "update",
+				"before":           map[string]interface{}{"id": "changed", "size": float64(1), "password": "old-secret"},
+				"after":            map[string]interface{}{"id": "changed", "size": float64(2), "password": "new-secret"},
+				"before_sensitive": map[string]interface{}{"password": true},
+				"after_sensitive":  map[string]interface{}{"password": true},
+			},
+			map[string]interface{}{
+				"address":          "test_instance.removed[0]",
+				"action":           "delete",
+				"before":           map[string]interface{}{"id": "removed"},
+				"after":            nil,
+				"before_sensitive": map[string]interface{}{},
+				"after_sensitive":  false,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong output\n%s", diff)
+	}
+}
+
+func TestStateDiff_noDifferences(t *testing.T) {
+	oldPath, _ := testStateDiffFiles(t)
+
+	streams, done := terminal.StreamsForTesting(t)
+	c := &StateDiffCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Streams:          streams,
+		},
+	}
+	if code := c.Run([]string{oldPath, oldPath}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, done(t).Stderr())
+	}
+
+	if got, want := done(t).Stdout(), "No differences found"; !strings.Contains(got, want) {
+		t.Errorf("missing %q in output\n%s", want, got)
+	}
+}
+
+// testStateDiffFiles writes two states that differ in an added, a removed
+// and a changed resource instance, returning their paths.
+func testStateDiffFiles(t *testing.T) (string, string) {
+	t.Helper()
+
+	provider := addrs.AbsProviderConfig{
+		Provider: addrs.NewDefaultProvider("test"),
+		Module:   addrs.RootModule,
+	}
+	instance := func(name string, key addrs.InstanceKey) addrs.AbsResourceInstance {
+		return addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: name,
+		}.Instance(key).Absolute(addrs.RootModuleInstance)
+	}
+	secret := []cty.PathValueMarks{{
+		Path:  cty.GetAttrPath("password"),
+		Marks: cty.NewValueMarks(marks.Sensitive),
+	}}
+
+	oldState := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(instance("same", addrs.NoKey), &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"id":"same"}`),
+			Status:    states.ObjectReady,
+		}, provider)
+		s.SetResourceInstanceCurrent(instance("changed", addrs.NoKey), &states.ResourceInstanceObjectSrc{
+			AttrsJSON:          []byte(`{"id":"changed","size":1,"password":"old-secret"}`),
+			AttrSensitivePaths: secret,
+			Status:             states.ObjectReady,
+		}, provider)
+		s.SetResourceInstanceCurrent(instance("removed", addrs.IntKey(0)), &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"id":"removed"}`),
+			Status:    states.ObjectReady,
+		}, provider)
+	})
+	newState := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(instance("same", addrs.NoKey), &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"id":"same"}`),
+			Status:    states.ObjectReady,
+		}, provider)
+		s.SetResourceInstanceCurrent(instance("changed", addrs.NoKey), &states.ResourceInstanceObjectSrc{
+			AttrsJSON:          []byte(`{"id":"changed","size":2,"password":"new-secret"}`),
+			AttrSensitivePaths: secret,
+			Status:             states.ObjectReady,
+		}, provider)
+		s.SetResourceInstanceCurrent(instance("added", addrs.StringKey("a")), &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"id":"added","tags":["x"]}`),
+			Status:    states.ObjectReady,
+		}, provider)
+	})
+	return testStateFile(t, oldState), testStateFile(t, newState)
+}
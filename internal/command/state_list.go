@@ -6,6 +6,7 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -30,6 +31,7 @@ func (c *StateListCommand) Run(args []string) int {
 	c.Meta.varFlagSet(cmdFlags)
 	cmdFlags.StringVar(&statePath, "state", "", "path")
 	lookupId := cmdFlags.String("id", "", "Restrict output to paths with a resource having the specified ID.")
+	jsonOutput := cmdFlags.Bool("json", false, "Produce JSON output.")
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
 		return cli.RunResultHelp
@@ -79,31 +81,100 @@ func (c *StateListCommand) Run(args []string) int {
 		return 1
 	}
 
-	var addrs []addrs.AbsResourceInstance
+	var instAddrs []addrs.AbsResourceInstance
 	var diags tfdiags.Diagnostics
 	if len(args) == 0 {
-		addrs, diags = c.lookupAllResourceInstanceAddrs(state)
+		instAddrs, diags = c.lookupAllResourceInstanceAddrs(state)
 	} else {
-		addrs, diags = c.lookupResourceInstanceAddrs(state, args...)
+		instAddrs, diags = c.lookupResourceInstanceAddrs(state, args...)
 	}
 	if diags.HasErrors() {
 		c.showDiagnostics(diags)
 		return 1
 	}
 
-	for _, addr := range addrs {
+	// The resource instances that passed the -id filter, in the order they
+	// were returned by the address lookup.
+	var matched []addrs.AbsResourceInstance
+	for _, addr := range instAddrs {
 		if is := state.ResourceInstance(addr); is != nil {
 			if *lookupId == "" || *lookupId == states.LegacyInstanceObjectID(is.Current) {
-				c.Ui.Output(addr.String())
+				matched = append(matched, addr)
 			}
 		}
 	}
 
+	if *jsonOutput {
+		// Diagnostics are always written to stderr, so they can't corrupt
+		// the JSON document we write to stdout.
+		c.showDiagnostics(diags)
+
+		out, err := json.MarshalIndent(stateListJSON(state, matched), "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal state list to JSON: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(out))
+		return 0
+	}
+
+	for _, addr := range matched {
+		c.Ui.Output(addr.String())
+	}
+
 	c.showDiagnostics(diags)
 
 	return 0
 }
 
+// stateListResource is the JSON representation of a single resource in the
+// output of "tofu state list -json".
+type stateListResource struct {
+	Address       string `json:"address"`
+	Mode          string `json:"mode"`
+	Provider      string `json:"provider"`
+	InstanceCount int    `json:"instance_count"`
+}
+
+// stateListJSON groups the given resource instance addresses by their
+// containing resource, preserving the order in which each resource first
+// appears. The result is never nil, so that an empty list is rendered as
+// an empty JSON array.
+func stateListJSON(state *states.State, instAddrs []addrs.AbsResourceInstance) []stateListResource {
+	ret := make([]stateListResource, 0, len(instAddrs))
+	index := make(map[string]int)
+	for _, addr := range instAddrs {
+		resAddr := addr.ContainingResource()
+		key := resAddr.String()
+		if i, exists := index[key]; exists {
+			ret[i].InstanceCount++
+			continue
+		}
+
+		var mode string
+		switch resAddr.Resource.Mode {
+		case addrs.ManagedResourceMode:
+			mode = "managed"
+		case addrs.DataResourceMode:
+			mode = "data"
+		}
+
+		var provider string
+		if rs := state.Resource(resAddr); rs != nil {
+			provider = rs.ProviderConfig.Provider.String()
+		}
+
+		index[key] = len(ret)
+		ret = append(ret, stateListResource{
+			Address:       key,
+			Mode:          mode,
+			Provider:      provider,
+			InstanceCount: 1,
+		})
+	}
+	return ret
+}
+
 func (c *StateListCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] state (list|ls) [options] [address...]
@@ -135,6 +206,10 @@ Options:
                       resource types have an attribute named "id" whose value
                       equals the given id string.
 
+  -json               Produce the list as a JSON array of resources, each
+                      with its address, mode, provider, and the number of
+                      matching instances. Diagnostics are written to stderr.
+
   -var 'foo=bar'      Set a value for one of the input variables in the root
                       module of the configuration. Use this option more than
                       once to set more than one variable.
@@ -6,9 +6,12 @@
 package command
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/mitchellh/cli"
 )
 
@@ -99,6 +102,71 @@ func TestStateListWithNonExistentID(t *testing.T) {
 	}
 }
 
+func TestStateList_json(t *testing.T) {
+	state := testState()
+	statePath := testStateFile(t, state)
+
+	p := testProvider()
+	ui := cli.NewMockUi()
+	c := &StateListCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-json",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	var got []stateListResource
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+	want := []stateListResource{
+		{
+			Address:       "test_instance.foo",
+			Mode:          "managed",
+			Provider:      "registry.opentofu.org/hashicorp/test",
+			InstanceCount: 1,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("wrong result\n%s", diff)
+	}
+}
+
+func TestStateList_jsonEmpty(t *testing.T) {
+	state := testState()
+	statePath := testStateFile(t, state)
+
+	p := testProvider()
+	ui := cli.NewMockUi()
+	c := &StateListCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-id", "baz",
+		"-json",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if got, want := ui.OutputWriter.String(), "[]\n"; got != want {
+		t.Fatalf("wrong output\ngot:  %q\nwant: %q", got, want)
+	}
+}
+
 func TestStateList_backendDefaultState(t *testing.T) {
 	// Create a temporary working directory that is empty
 	td := t.TempDir()
@@ -259,6 +327,28 @@ func TestStateList_modules(t *testing.T) {
 		}
 	})
 
+	t.Run("expanded module json", func(t *testing.T) {
+		ui.OutputWriter.Reset()
+		args := []string{"-json", "module.count"}
+		if code := c.Run(args); code != 0 {
+			t.Fatalf("bad: %d", code)
+		}
+
+		var got []stateListResource
+		if err := json.Unmarshal(ui.OutputWriter.Bytes(), &got); err != nil {
+			t.Fatalf("output is not valid JSON: %s\n\n%s", err, ui.OutputWriter.String())
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected one entry per module instance, got %#v", got)
+		}
+		for i, res := range got {
+			wantAddr := fmt.Sprintf("module.count[%d].test_instance.count", i)
+			if res.Address != wantAddr || res.InstanceCount != 1 || res.Mode != "managed" {
+				t.Errorf("wrong entry %d: %#v", i, res)
+			}
+		}
+	})
+
 	t.Run("completely nonexistent module", func(t *testing.T) {
 		// finally get the state for a module with an index
 		ui.OutputWriter.Reset()
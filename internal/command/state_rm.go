@@ -6,6 +6,7 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/command/arguments"
 	"github.com/opentofu/opentofu/internal/command/clistate"
+	"github.com/opentofu/opentofu/internal/command/format"
 	"github.com/opentofu/opentofu/internal/command/views"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
@@ -26,9 +28,10 @@ type StateRmCommand struct {
 
 func (c *StateRmCommand) Run(args []string) int {
 	args = c.Meta.process(args)
-	var dryRun bool
+	var dryRun, jsonOutput bool
 	cmdFlags := c.Meta.ignoreRemoteVersionFlagSet("state rm")
 	cmdFlags.BoolVar(&dryRun, "dry-run", false, "dry run")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.StringVar(&c.backupPath, "backup", "-", "backup")
 	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
 	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
@@ -43,6 +46,10 @@ func (c *StateRmCommand) Run(args []string) int {
 		c.Ui.Error("At least one address is required.\n")
 		return cli.RunResultHelp
 	}
+	if jsonOutput && !dryRun {
+		c.Ui.Error("The -json option is only supported together with -dry-run.\n")
+		return cli.RunResultHelp
+	}
 
 	if diags := c.Meta.checkRequiredVersion(); diags != nil {
 		c.showDiagnostics(diags)
@@ -63,7 +70,9 @@ func (c *StateRmCommand) Run(args []string) int {
 		return 1
 	}
 
-	if c.stateLock {
+	// A dry run only reads the state, so it doesn't need to hold a lock that
+	// would block others from changing it in the meantime.
+	if c.stateLock && !dryRun {
 		stateLocker := clistate.NewLocker(c.stateLockTimeout, views.NewStateLocker(arguments.ViewHuman, c.View))
 		if diags := stateLocker.Lock(stateMgr, "state-rm"); diags.HasErrors() {
 			c.showDiagnostics(diags)
@@ -90,7 +99,7 @@ func (c *StateRmCommand) Run(args []string) int {
 	// This command primarily works with resource instances, though it will
 	// also clean up any modules and resources left empty by actions it takes.
 	var addrs []addrs.AbsResourceInstance
-	var diags tfdiags.Diagnostics
+	diags := encDiags
 	for _, addrStr := range args {
 		moreAddrs, moreDiags := c.lookupResourceInstanceAddr(state, true, addrStr)
 		addrs = append(addrs, moreAddrs...)
@@ -101,6 +110,22 @@ func (c *StateRmCommand) Run(args []string) int {
 		return 1
 	}
 
+	if jsonOutput {
+		c.showDiagnosticsOnStderr(diags)
+
+		addrStrs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			addrStrs[i] = addr.String()
+		}
+		out, err := json.MarshalIndent(addrStrs, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal resource instance addresses to JSON: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(out))
+		return 0
+	}
+
 	prefix := "Removed "
 	if dryRun {
 		prefix = "Would remove "
@@ -166,6 +191,21 @@ func (c *StateRmCommand) Run(args []string) int {
 	return 0
 }
 
+// showDiagnosticsOnStderr is like showDiagnostics, except that it writes
+// warnings to stderr along with errors, rather than to stdout where they
+// would corrupt the JSON document written by -json.
+func (c *StateRmCommand) showDiagnosticsOnStderr(diags tfdiags.Diagnostics) {
+	diags.Sort()
+	outputWidth := c.ErrorColumns()
+	for _, diag := range diags.ConsolidateWarnings(1) {
+		if c.Color {
+			c.Ui.Error(format.Diagnostic(diag, c.configSources(), c.Colorize(), outputWidth))
+		} else {
+			c.Ui.Error(format.DiagnosticPlain(diag, c.configSources(), outputWidth))
+		}
+	}
+}
+
 func (c *StateRmCommand) Help() string {
 	helpText := `
 Usage: tofu [global options] state (remove|rm) [options] ADDRESS...
@@ -186,7 +226,11 @@ Usage: tofu [global options] state (remove|rm) [options] ADDRESS...
 Options:
 
   -dry-run                If set, prints out what would've been removed but
-                          doesn't actually remove anything.
+                          doesn't actually remove anything. The state is not
+                          locked during a dry run.
+
+  -json                   With -dry-run, print the addresses of the resource
+                          instances that would be removed as a JSON array.
 
   -backup=PATH            Path where OpenTofu should write the backup
                           state.
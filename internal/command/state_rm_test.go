@@ -84,6 +84,168 @@ func TestStateRm(t *testing.T) {
 	testStateOutput(t, backups[0], testStateRmOutputOriginal)
 }
 
+func TestStateRm_dryRun(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		for name, id := range map[string]string{"foo": "bar", "bar": "foo"} {
+			s.SetResourceInstanceCurrent(
+				addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: name,
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				&states.ResourceInstanceObjectSrc{
+					AttrsJSON: []byte(`{"id":"` + id + `","foo":"value","bar":"value"}`),
+					Status:    states.ObjectReady,
+				},
+				addrs.AbsProviderConfig{
+					Provider: addrs.NewDefaultProvider("test"),
+					Module:   addrs.RootModule,
+				},
+			)
+		}
+	})
+
+	tests := map[string]struct {
+		args []string
+		want string
+	}{
+		"human": {
+			args: []string{"-dry-run", "test_instance.foo"},
+			want: "Would remove test_instance.foo\n",
+		},
+		"json": {
+			args: []string{"-dry-run", "-json", "test_instance.foo", "test_instance.bar"},
+			want: "[\n  \"test_instance.foo\",\n  \"test_instance.bar\"\n]\n",
+		},
+		"json no matches": {
+			args: []string{"-dry-run", "-json", "test_instance.baz"},
+			want: "[]\n",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			statePath := testStateFile(t, state)
+
+			// A dry run must not need the state lock, so it should succeed
+			// while something else holds it.
+			unlock, err := testLockState(t, testDataDir, statePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer unlock()
+
+			ui := new(cli.MockUi)
+			view, _ := testView(t)
+			c := &StateRmCommand{
+				StateMeta{
+					Meta: Meta{
+						testingOverrides: metaOverridesForProvider(testProvider()),
+						Ui:               ui,
+						View:             view,
+					},
+				},
+			}
+
+			args := append([]string{"-state", statePath}, test.args...)
+			if code := c.Run(args); code != 0 {
+				t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+			}
+			if got := ui.OutputWriter.String(); got != test.want {
+				t.Errorf("wrong output\ngot:\n%s\nwant:\n%s", got, test.want)
+			}
+
+			// Nothing was removed, and no backup was written.
+			testStateOutput(t, statePath, testStateRmOutputOriginal)
+			if backups := testStateBackups(t, filepath.Dir(statePath)); len(backups) != 0 {
+				t.Fatalf("unexpected backups: %#v", backups)
+			}
+		})
+	}
+}
+
+func TestStateRm_jsonWithoutDryRun(t *testing.T) {
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &StateRmCommand{
+		StateMeta{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				Ui:               ui,
+				View:             view,
+			},
+		},
+	}
+
+	if code := c.Run([]string{"-json", "test_instance.foo"}); code != cli.RunResultHelp {
+		t.Fatalf("wrong exit code %d; want %d", code, cli.RunResultHelp)
+	}
+	if got, want := ui.ErrorWriter.String(), "only supported together with -dry-run"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
+func TestStateRm_jsonWarnings(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"bar","foo":"value","bar":"value"}`),
+				Status:    states.ObjectReady,
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewDefaultProvider("test"),
+				Module:   addrs.RootModule,
+			},
+		)
+	})
+	statePath := testStateFile(t, state)
+
+	// Configuring the unencrypted method produces a warning.
+	t.Setenv(encryptionConfigEnvName, `
+method "unencrypted" "migrate" {}
+state {
+  method = method.unencrypted.migrate
+}
+`)
+
+	// The tofu command's Ui writes warnings to stdout.
+	ui := new(cli.MockUi)
+	view, _ := testView(t)
+	c := &StateRmCommand{
+		StateMeta{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(testProvider()),
+				Ui:               warnToOutputUi{ui},
+				View:             view,
+			},
+		},
+	}
+
+	if code := c.Run([]string{"-state", statePath, "-dry-run", "-json", "test_instance.foo"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if got, want := ui.OutputWriter.String(), "[\n  \"test_instance.foo\"\n]\n"; got != want {
+		t.Errorf("wrong output\ngot:\n%s\nwant:\n%s", got, want)
+	}
+	if got, want := ui.ErrorWriter.String(), "Unencrypted method configured"; !strings.Contains(got, want) {
+		t.Errorf("wrong error output\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
+// warnToOutputUi is a cli.Ui that writes warnings as normal output, like the
+// one used by the tofu command.
+type warnToOutputUi struct {
+	*cli.MockUi
+}
+
+func (u warnToOutputUi) Warn(msg string) {
+	u.Output(msg)
+}
+
 func TestStateRmNotChildModule(t *testing.T) {
 	state := states.BuildState(func(s *states.SyncState) {
 		s.SetResourceInstanceCurrent(
@@ -0,0 +1,9 @@
+resource "test_instance" "foo" {
+}
+
+resource "test_instance" "bar" {
+  for_each = toset(["a b"])
+}
+
+resource "test_instance" "fail" {
+}
@@ -25,11 +25,16 @@ type Apply interface {
 	Hooks() []tofu.Hook
 
 	Diagnostics(diags tfdiags.Diagnostics)
+	WarningSummary()
 	HelpPrompt()
 }
 
 // NewApply returns an initialized Apply implementation for the given ViewType.
-func NewApply(vt arguments.ViewType, destroy bool, view *View) Apply {
+//
+// If consolidateWarnings is set, the human view holds back all warnings
+// instead of rendering them as they arrive, and renders each distinct warning
+// once when WarningSummary is called. The JSON view ignores it.
+func NewApply(vt arguments.ViewType, destroy bool, consolidateWarnings bool, view *View) Apply {
 	switch vt {
 	case arguments.ViewJSON:
 		return &ApplyJSON{
@@ -39,10 +44,11 @@ func NewApply(vt arguments.ViewType, destroy bool, view *View) Apply {
 		}
 	case arguments.ViewHuman:
 		return &ApplyHuman{
-			view:         view,
-			destroy:      destroy,
-			inAutomation: view.RunningInAutomation(),
-			countHook:    &countHook{},
+			view:                view,
+			destroy:             destroy,
+			inAutomation:        view.RunningInAutomation(),
+			consolidateWarnings: consolidateWarnings,
+			countHook:           &countHook{},
 		}
 	default:
 		panic(fmt.Sprintf("unknown view type %v", vt))
@@ -57,6 +63,12 @@ type ApplyHuman struct {
 	destroy      bool
 	inAutomation bool
 
+	// consolidateWarnings causes all warnings to be held back in warnings
+	// until WarningSummary renders them, while errors are still rendered
+	// immediately.
+	consolidateWarnings bool
+	warnings            tfdiags.Diagnostics
+
 	countHook *countHook
 }
 
@@ -98,7 +110,11 @@ func (v *ApplyHuman) Outputs(outputValues map[string]*states.OutputValue) {
 }
 
 func (v *ApplyHuman) Operation() Operation {
-	return NewOperation(arguments.ViewHuman, v.inAutomation, v.view)
+	op := NewOperation(arguments.ViewHuman, v.inAutomation, v.view)
+	if v.consolidateWarnings {
+		return &consolidatingOperationHuman{OperationHuman: op.(*OperationHuman), apply: v}
+	}
+	return op
 }
 
 func (v *ApplyHuman) Hooks() []tofu.Hook {
@@ -109,7 +125,63 @@ func (v *ApplyHuman) Hooks() []tofu.Hook {
 }
 
 func (v *ApplyHuman) Diagnostics(diags tfdiags.Diagnostics) {
-	v.view.Diagnostics(diags)
+	if !v.consolidateWarnings {
+		v.view.Diagnostics(diags)
+		return
+	}
+
+	var errs tfdiags.Diagnostics
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning {
+			v.warnings = v.warnings.Append(diag)
+		} else {
+			errs = errs.Append(diag)
+		}
+	}
+	v.view.Diagnostics(errs)
+}
+
+// WarningSummary renders the warnings that were held back because of
+// consolidateWarnings, showing the first warning with each distinct summary
+// along with how many times it occurred in total. If compact warnings were
+// also requested then only the summaries and their counts are shown.
+func (v *ApplyHuman) WarningSummary() {
+	if len(v.warnings) == 0 {
+		return
+	}
+
+	var summaries []string
+	groups := make(map[string]tfdiags.Diagnostics)
+	for _, diag := range v.warnings {
+		summary := diag.Description().Summary
+		if _, ok := groups[summary]; !ok {
+			summaries = append(summaries, summary)
+		}
+		groups[summary] = groups[summary].Append(diag)
+	}
+	v.warnings = nil
+
+	v.view.streams.Print(v.view.colorize.Color("[reset][bold][yellow]\nWarning summary:[reset]\n"))
+	if v.view.compactWarnings {
+		v.view.streams.Println()
+		for _, summary := range summaries {
+			v.view.streams.Printf("- %s\n", summary)
+			if count := len(groups[summary]); count > 1 {
+				v.view.streams.Printf("  (%d occurrences of this warning)\n", count)
+			}
+		}
+		v.view.streams.Print("\nTo see the full warning notes, run OpenTofu without -compact-warnings.\n")
+		return
+	}
+	for _, summary := range summaries {
+		group := groups[summary]
+		v.view.Diagnostics(group[:1])
+		if more := len(group) - 1; more == 1 {
+			v.view.streams.Println("(and 1 more occurrence of this warning)")
+		} else if more > 1 {
+			v.view.streams.Printf("(and %d more occurrences of this warning)\n", more)
+		}
+	}
 }
 
 func (v *ApplyHuman) HelpPrompt() {
@@ -120,6 +192,19 @@ func (v *ApplyHuman) HelpPrompt() {
 	v.view.HelpPrompt(command)
 }
 
+// consolidatingOperationHuman is the Operation view of an ApplyHuman that is
+// consolidating warnings, which passes diagnostics on to the apply view so
+// that warnings arising during the operation are held back too.
+type consolidatingOperationHuman struct {
+	*OperationHuman
+
+	apply *ApplyHuman
+}
+
+func (v *consolidatingOperationHuman) Diagnostics(diags tfdiags.Diagnostics) {
+	v.apply.Diagnostics(diags)
+}
+
 const stateOutPathPostApply = "The state of your infrastructure has been saved to the path below. This state is required to modify and destroy your infrastructure, so keep it safe. To inspect the complete state use the `tofu show` command."
 
 // The ApplyJSON implementation renders streaming JSON logs, suitable for
@@ -172,5 +257,8 @@ func (v *ApplyJSON) Diagnostics(diags tfdiags.Diagnostics) {
 	v.view.Diagnostics(diags)
 }
 
+func (v *ApplyJSON) WarningSummary() {
+}
+
 func (v *ApplyJSON) HelpPrompt() {
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/terminal"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -22,7 +23,7 @@ import (
 func TestApply_new(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	defer done(t)
-	v := NewApply(arguments.ViewHuman, false, NewView(streams).SetRunningInAutomation(true))
+	v := NewApply(arguments.ViewHuman, false, true, NewView(streams).SetRunningInAutomation(true))
 	hv, ok := v.(*ApplyHuman)
 	if !ok {
 		t.Fatalf("unexpected return type %t", v)
@@ -32,16 +33,104 @@ func TestApply_new(t *testing.T) {
 		t.Fatalf("unexpected destroy value")
 	}
 
+	if hv.consolidateWarnings != true {
+		t.Fatalf("unexpected consolidateWarnings value")
+	}
+
 	if hv.inAutomation != true {
 		t.Fatalf("unexpected inAutomation value")
 	}
 }
 
+// With consolidated warnings, errors are rendered immediately while warnings
+// are held back until WarningSummary, which shows each distinct one once.
+func TestApplyHuman_consolidateWarnings(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	v := NewApply(arguments.ViewHuman, false, true, NewView(streams))
+
+	var diags tfdiags.Diagnostics
+	for i := 0; i < 3; i++ {
+		diags = diags.Append(tfdiags.Sourceless(tfdiags.Warning, "Argument is deprecated", fmt.Sprintf("Instance %d uses a deprecated argument.", i)))
+	}
+	v.Diagnostics(diags)
+	v.Operation().Diagnostics(tfdiags.Diagnostics{}.Append(
+		tfdiags.Sourceless(tfdiags.Warning, "Other warning", "Something else."),
+		tfdiags.Sourceless(tfdiags.Error, "Apply failed", "Something went wrong."),
+	))
+
+	output := done(t)
+	if got := output.Stdout(); got != "" {
+		t.Errorf("warnings were rendered before the summary\n%s", got)
+	}
+	if got, want := output.Stderr(), "Error: Apply failed"; !strings.Contains(got, want) {
+		t.Errorf("error was not rendered immediately\ngot:  %q\nwant: %q", got, want)
+	}
+
+	streams, done = terminal.StreamsForTesting(t)
+	v.(*ApplyHuman).view = NewView(streams)
+	v.WarningSummary()
+	got := done(t).Stdout()
+	want := `
+Warning summary:
+
+Warning: Argument is deprecated
+
+Instance 0 uses a deprecated argument.
+(and 2 more occurrences of this warning)
+
+Warning: Other warning
+
+Something else.
+`
+	if got != want {
+		t.Errorf("wrong summary\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The summary is only rendered once.
+	streams, done = terminal.StreamsForTesting(t)
+	v.(*ApplyHuman).view = NewView(streams)
+	v.WarningSummary()
+	if got := done(t).Stdout(); got != "" {
+		t.Errorf("summary was rendered again\n%s", got)
+	}
+}
+
+// With both consolidated and compact warnings, the summary shows only the
+// distinct warning summaries and how many times each occurred.
+func TestApplyHuman_consolidateCompactWarnings(t *testing.T) {
+	streams, done := terminal.StreamsForTesting(t)
+	view := NewView(streams)
+	view.Configure(&arguments.View{NoColor: true, CompactWarnings: true})
+	v := NewApply(arguments.ViewHuman, false, true, view)
+
+	var diags tfdiags.Diagnostics
+	for i := 0; i < 3; i++ {
+		diags = diags.Append(tfdiags.Sourceless(tfdiags.Warning, "Argument is deprecated", fmt.Sprintf("Instance %d uses a deprecated argument.", i)))
+	}
+	diags = diags.Append(tfdiags.Sourceless(tfdiags.Warning, "Other warning", "Something else."))
+	v.Diagnostics(diags)
+	v.WarningSummary()
+
+	got := done(t).Stdout()
+	want := `
+Warning summary:
+
+- Argument is deprecated
+  (3 occurrences of this warning)
+- Other warning
+
+To see the full warning notes, run OpenTofu without -compact-warnings.
+`
+	if got != want {
+		t.Errorf("wrong summary\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
 // Basic test coverage of Outputs, since most of its functionality is tested
 // elsewhere.
 func TestApplyHuman_outputs(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
-	v := NewApply(arguments.ViewHuman, false, NewView(streams))
+	v := NewApply(arguments.ViewHuman, false, false, NewView(streams))
 
 	v.Outputs(map[string]*states.OutputValue{
 		"foo": {Value: cty.StringVal("secret")},
@@ -58,7 +147,7 @@ func TestApplyHuman_outputs(t *testing.T) {
 // Outputs should do nothing if there are no outputs to render.
 func TestApplyHuman_outputsEmpty(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
-	v := NewApply(arguments.ViewHuman, false, NewView(streams))
+	v := NewApply(arguments.ViewHuman, false, false, NewView(streams))
 
 	v.Outputs(map[string]*states.OutputValue{})
 
@@ -73,7 +162,7 @@ func TestApplyHuman_outputsEmpty(t *testing.T) {
 func TestApplyHuman_operation(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	defer done(t)
-	v := NewApply(arguments.ViewHuman, false, NewView(streams).SetRunningInAutomation(true)).Operation()
+	v := NewApply(arguments.ViewHuman, false, false, NewView(streams).SetRunningInAutomation(true)).Operation()
 	if hv, ok := v.(*OperationHuman); !ok {
 		t.Fatalf("unexpected return type %t", v)
 	} else if hv.inAutomation != true {
@@ -92,7 +181,7 @@ func TestApplyHuman_help(t *testing.T) {
 	for name, destroy := range testCases {
 		t.Run(name, func(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
-			v := NewApply(arguments.ViewHuman, destroy, NewView(streams))
+			v := NewApply(arguments.ViewHuman, destroy, false, NewView(streams))
 			v.HelpPrompt()
 			got := done(t).Stderr()
 			if !strings.Contains(got, name) {
@@ -134,7 +223,7 @@ func TestApply_resourceCount(t *testing.T) {
 		for _, viewType := range views {
 			t.Run(fmt.Sprintf("%s (%s view)", name, viewType), func(t *testing.T) {
 				streams, done := terminal.StreamsForTesting(t)
-				v := NewApply(viewType, tc.destroy, NewView(streams))
+				v := NewApply(viewType, tc.destroy, false, NewView(streams))
 				hooks := v.Hooks()
 
 				var count *countHook
@@ -207,7 +296,7 @@ func TestApplyHuman_resourceCountStatePath(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			streams, done := terminal.StreamsForTesting(t)
-			v := NewApply(arguments.ViewHuman, false, NewView(streams))
+			v := NewApply(arguments.ViewHuman, false, false, NewView(streams))
 			hooks := v.Hooks()
 
 			var count *countHook
@@ -242,7 +331,7 @@ func TestApplyHuman_resourceCountStatePath(t *testing.T) {
 // elsewhere.
 func TestApplyJSON_outputs(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
-	v := NewApply(arguments.ViewJSON, false, NewView(streams))
+	v := NewApply(arguments.ViewJSON, false, false, NewView(streams))
 
 	v.Outputs(map[string]*states.OutputValue{
 		"boop_count": {Value: cty.NumberIntVal(92)},
@@ -76,7 +76,7 @@ func (h *jsonHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generatio
 	progress := applyProgress{
 		addr:          addr,
 		action:        action,
-		start:         h.timeNow().Round(time.Second),
+		start:         h.timeNow(),
 		elapsed:       make(chan time.Duration),
 		done:          make(chan struct{}),
 		heartbeatDone: make(chan struct{}),
@@ -101,7 +101,7 @@ func (h *jsonHook) applyingHeartbeat(progress applyProgress) {
 		case <-h.timeAfter(heartbeatInterval):
 		}
 
-		elapsed := h.timeNow().Round(time.Second).Sub(progress.start)
+		elapsed := h.elapsed(progress)
 		h.view.Hook(json.NewApplyProgress(progress.addr, progress.action, elapsed))
 		progress.elapsed <- elapsed
 	}
@@ -121,7 +121,7 @@ func (h *jsonHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 		return tofu.HookActionContinue, nil
 	}
 
-	elapsed := h.timeNow().Round(time.Second).Sub(progress.start)
+	elapsed := h.elapsed(progress)
 
 	if err != nil {
 		// Errors are collected and displayed post-apply, so no need to
@@ -135,6 +135,17 @@ func (h *jsonHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return tofu.HookActionContinue, nil
 }
 
+// elapsed returns the time since the PreApply call for the given progress,
+// rounded to the nearest second.
+//
+// The start time keeps the monotonic clock reading from timeNow, so that the
+// result isn't affected by changes to the system's wall clock during the
+// apply. Rounding a time.Time would strip that reading, so only the resulting
+// duration is rounded.
+func (h *jsonHook) elapsed(progress applyProgress) time.Duration {
+	return h.timeNow().Sub(progress.start).Round(time.Second)
+}
+
 func (h *jsonHook) PreProvisionInstanceStep(addr addrs.AbsResourceInstance, typeName string) (tofu.HookAction, error) {
 	h.view.Hook(json.NewProvisionStart(addr, typeName))
 	return tofu.HookActionContinue, nil
@@ -197,6 +197,14 @@ func TestJSONHook_errors(t *testing.T) {
 	streams, done := terminal.StreamsForTesting(t)
 	hook := newJSONHook(NewJSONView(NewView(streams)))
 
+	var nowMu sync.Mutex
+	now := time.Now()
+	hook.timeNow = func() time.Time {
+		nowMu.Lock()
+		defer nowMu.Unlock()
+		return now
+	}
+
 	addr := addrs.Resource{
 		Mode: addrs.ManagedResourceMode,
 		Type: "test_instance",
@@ -220,6 +228,11 @@ func TestJSONHook_errors(t *testing.T) {
 	action, err = hook.PostProvisionInstanceStep(addr, "local-exec", provisionError)
 	testHookReturnValues(t, action, err)
 
+	// Make the apply take just over 3 seconds
+	nowMu.Lock()
+	now = now.Add(3*time.Second + 200*time.Millisecond)
+	nowMu.Unlock()
+
 	applyError := fmt.Errorf("provider was sad")
 	action, err = hook.PostApply(addr, states.CurrentGen, plannedNewState, applyError)
 	testHookReturnValues(t, action, err)
@@ -266,12 +279,12 @@ func TestJSONHook_errors(t *testing.T) {
 		},
 		{
 			"@level":   "info",
-			"@message": "test_instance.boop: Destruction errored after 0s",
+			"@message": "test_instance.boop: Destruction errored after 3s",
 			"@module":  "tofu.ui",
 			"type":     "apply_errored",
 			"hook": map[string]interface{}{
 				"action":          string("delete"),
-				"elapsed_seconds": float64(0),
+				"elapsed_seconds": float64(3),
 				"resource":        wantResource,
 			},
 		},
@@ -106,6 +106,7 @@ func (v *OperationHuman) Plan(plan *plans.Plan, schemas *tofu.Schemas) {
 		Streams:             v.view.streams,
 		RunningInAutomation: v.inAutomation,
 		ShowSensitive:       v.view.showSensitive,
+		Concise:             v.view.concise,
 	}
 
 	jplan := jsonformat.Plan{
@@ -133,25 +133,30 @@ func (v *OutputRaw) Output(name string, outputs map[string]*states.OutputValue)
 		return diags
 	}
 
-	strV, err := convert.Convert(output.Value, cty.String)
-	if err != nil {
+	// A null value has no textual form whatever its type, so we report
+	// that before checking the type.
+	if output.Value.IsNull() {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Unsupported value for raw output",
 			fmt.Sprintf(
-				"The -raw option only supports strings, numbers, and boolean values, but output value %q is %s.\n\nUse the -json option for machine-readable representations of output values that have complex types.",
-				name, output.Value.Type().FriendlyName(),
+				"The value for output value %q is null, so -raw mode cannot print it.",
+				name,
 			),
 		))
 		return diags
 	}
-	if strV.IsNull() {
+
+	// Numbers and booleans convert to their canonical textual forms, such
+	// as 1.5 and true, while collections and objects can't be converted.
+	strV, err := convert.Convert(output.Value, cty.String)
+	if err != nil {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
 			"Unsupported value for raw output",
 			fmt.Sprintf(
-				"The value for output value %q is null, so -raw mode cannot print it.",
-				name,
+				"The -raw option only supports strings, numbers, and boolean values, but output value %q is %s.\n\nUse the -json option for machine-readable representations of output values that have complex types.",
+				name, output.Value.Type().FriendlyName(),
 			),
 		))
 		return diags
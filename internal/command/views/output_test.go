@@ -261,9 +261,16 @@ func TestOutputRaw(t *testing.T) {
 		"str":      cty.StringVal("bar"),
 		"multistr": cty.StringVal("bar\nbaz"),
 		"num":      cty.NumberIntVal(2),
+		"negative": cty.NumberIntVal(-15),
+		"float":    cty.NumberFloatVal(1.5),
+		"bignum":   cty.MustParseNumberVal("12345678901234567890"),
 		"bool":     cty.True,
+		"false":    cty.False,
 		"obj":      cty.EmptyObjectVal,
+		"list":     cty.ListVal([]cty.Value{cty.NumberIntVal(1)}),
 		"null":     cty.NullVal(cty.String),
+		"nullnum":  cty.NullVal(cty.Number),
+		"nullobj":  cty.NullVal(cty.EmptyObject),
 		"unknown":  cty.UnknownVal(cty.String),
 	}
 
@@ -274,9 +281,16 @@ func TestOutputRaw(t *testing.T) {
 		"str":      {WantOutput: "bar"},
 		"multistr": {WantOutput: "bar\nbaz"},
 		"num":      {WantOutput: "2"},
+		"negative": {WantOutput: "-15"},
+		"float":    {WantOutput: "1.5"},
+		"bignum":   {WantOutput: "12345678901234567890"},
 		"bool":     {WantOutput: "true"},
+		"false":    {WantOutput: "false"},
 		"obj":      {WantErr: true},
+		"list":     {WantErr: true},
 		"null":     {WantErr: true},
+		"nullnum":  {WantErr: true},
+		"nullobj":  {WantErr: true},
 		"unknown":  {WantErr: true},
 	}
 
@@ -54,6 +54,7 @@ func (v *ShowHuman) Display(config *configs.Config, plan *plans.Plan, planJSON *
 		Streams:             v.view.streams,
 		RunningInAutomation: v.view.runningInAutomation,
 		ShowSensitive:       v.view.showSensitive,
+		Concise:             v.view.concise,
 	}
 
 	// Prefer to display a pre-built JSON plan, if we got one; then, fall back
@@ -48,6 +48,7 @@ var _ Validate = (*ValidateHuman)(nil)
 
 func (v *ValidateHuman) Results(diags tfdiags.Diagnostics) int {
 	columns := v.view.outputColumns()
+	diags = uniqueDiagnostics(diags)
 
 	if len(diags) == 0 {
 		v.view.streams.Println(format.WordWrap(v.view.colorize.Color(validateSuccess), columns))
@@ -86,36 +87,50 @@ func (v *ValidateJSON) Results(diags tfdiags.Diagnostics) int {
 	// FormatVersion represents the version of the json format and will be
 	// incremented for any change to this format that requires changes to a
 	// consuming parser.
-	const FormatVersion = "1.0"
+	const FormatVersion = "1.1"
+
+	type Summary struct {
+		Valid        bool `json:"valid"`
+		ErrorCount   int  `json:"error_count"`
+		WarningCount int  `json:"warning_count"`
+	}
 
 	type Output struct {
 		FormatVersion string `json:"format_version"`
 
 		// We include some summary information that is actually redundant
 		// with the detailed diagnostics, but avoids the need for callers
-		// to re-implement our logic for deciding these.
+		// to re-implement our logic for deciding these. The top-level
+		// fields predate the summary object, and are kept for compatibility.
 		Valid        bool                    `json:"valid"`
 		ErrorCount   int                     `json:"error_count"`
 		WarningCount int                     `json:"warning_count"`
+		Summary      Summary                 `json:"summary"`
 		Diagnostics  []*viewsjson.Diagnostic `json:"diagnostics"`
 	}
 
 	output := Output{
 		FormatVersion: FormatVersion,
-		Valid:         true, // until proven otherwise
+	}
+	summary := Summary{
+		Valid: true, // until proven otherwise
 	}
 	configSources := v.view.configSources()
-	for _, diag := range diags {
+	for _, diag := range uniqueDiagnostics(diags) {
 		output.Diagnostics = append(output.Diagnostics, viewsjson.NewDiagnostic(diag, configSources))
 
 		switch diag.Severity() {
 		case tfdiags.Error:
-			output.ErrorCount++
-			output.Valid = false
+			summary.ErrorCount++
+			summary.Valid = false
 		case tfdiags.Warning:
-			output.WarningCount++
+			summary.WarningCount++
 		}
 	}
+	output.Summary = summary
+	output.Valid = summary.Valid
+	output.ErrorCount = summary.ErrorCount
+	output.WarningCount = summary.WarningCount
 	if output.Diagnostics == nil {
 		// Make sure this always appears as an array in our output, since
 		// this is easier to consume for dynamically-typed languages.
@@ -135,6 +150,29 @@ func (v *ValidateJSON) Results(diags tfdiags.Diagnostics) int {
 	return 0
 }
 
+// uniqueDiagnostics returns the given diagnostics without any that are exact
+// duplicates of an earlier one, which can happen when the same problem is
+// found through more than one path during validation. Both implementations of
+// Validate use this so that they report the same set of diagnostics.
+func uniqueDiagnostics(diags tfdiags.Diagnostics) tfdiags.Diagnostics {
+	var ret tfdiags.Diagnostics
+	for _, diag := range diags {
+		duplicate := false
+		if !tfdiags.DoNotConsolidateDiagnostic(diag) {
+			for _, existing := range ret {
+				if existing.Severity() == diag.Severity() && existing.Description().Equal(diag.Description()) && existing.Source().Equal(diag.Source()) {
+					duplicate = true
+					break
+				}
+			}
+		}
+		if !duplicate {
+			ret = ret.Append(diag)
+		}
+	}
+	return ret
+}
+
 // Diagnostics should only be called if the validation walk cannot be executed.
 // In this case, we choose to render human-readable diagnostic output,
 // primarily for backwards compatibility.
@@ -136,3 +136,96 @@ func TestValidateJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJSON_summary(t *testing.T) {
+	warning := tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Your shoelaces are untied",
+		"Watch out, or you'll trip!",
+	)
+	otherWarning := tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Your shoelaces are untied",
+		"The other ones, this time.",
+	)
+	err := tfdiags.Sourceless(
+		tfdiags.Error,
+		"Configuration is missing random_pet",
+		"Every configuration should have a random_pet.",
+	)
+
+	testCases := map[string]struct {
+		diags       []tfdiags.Diagnostic
+		wantValid   bool
+		wantErrors  int
+		wantWarning int
+	}{
+		"success": {
+			nil,
+			true, 0, 0,
+		},
+		"warnings only": {
+			[]tfdiags.Diagnostic{warning, otherWarning},
+			true, 0, 2,
+		},
+		"mixed": {
+			[]tfdiags.Diagnostic{warning, err, otherWarning},
+			false, 1, 2,
+		},
+		"duplicates": {
+			[]tfdiags.Diagnostic{warning, err, warning, err, otherWarning},
+			false, 1, 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags tfdiags.Diagnostics
+			for _, diag := range tc.diags {
+				diags = diags.Append(diag)
+			}
+
+			streams, done := terminal.StreamsForTesting(t)
+			view := NewView(streams)
+			view.Configure(&arguments.View{NoColor: true})
+			NewValidate(arguments.ViewJSON, view).Results(diags)
+
+			var result struct {
+				Valid        bool `json:"valid"`
+				ErrorCount   int  `json:"error_count"`
+				WarningCount int  `json:"warning_count"`
+				Summary      struct {
+					Valid        bool `json:"valid"`
+					ErrorCount   int  `json:"error_count"`
+					WarningCount int  `json:"warning_count"`
+				} `json:"summary"`
+				Diagnostics []map[string]interface{} `json:"diagnostics"`
+			}
+			if err := json.Unmarshal([]byte(done(t).Stdout()), &result); err != nil {
+				t.Fatal(err)
+			}
+
+			if result.Summary.Valid != tc.wantValid || result.Summary.ErrorCount != tc.wantErrors || result.Summary.WarningCount != tc.wantWarning {
+				t.Errorf("wrong summary: got %+v, want valid=%t error_count=%d warning_count=%d", result.Summary, tc.wantValid, tc.wantErrors, tc.wantWarning)
+			}
+			if result.Valid != result.Summary.Valid || result.ErrorCount != result.Summary.ErrorCount || result.WarningCount != result.Summary.WarningCount {
+				t.Errorf("top-level fields don't match the summary: %+v", result)
+			}
+			if got, want := len(result.Diagnostics), tc.wantErrors+tc.wantWarning; got != want {
+				t.Errorf("wrong number of diagnostics: got %d, want %d", got, want)
+			}
+
+			// The human-readable output must show the same diagnostics.
+			streams, done = terminal.StreamsForTesting(t)
+			view = NewView(streams)
+			view.Configure(&arguments.View{NoColor: true})
+			NewValidate(arguments.ViewHuman, view).Results(diags)
+			human := done(t).All()
+			if got := strings.Count(human, "Error: "); got != tc.wantErrors {
+				t.Errorf("human output has %d errors, want %d:\n%s", got, tc.wantErrors, human)
+			}
+			if got := strings.Count(human, "Warning: "); got != tc.wantWarning {
+				t.Errorf("human output has %d warnings, want %d:\n%s", got, tc.wantWarning, human)
+			}
+		})
+	}
+}
@@ -7,11 +7,14 @@ package command
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/posener/complete"
 
+	"github.com/opentofu/opentofu/internal/backend"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -24,8 +27,10 @@ func (c *WorkspaceListCommand) Run(args []string) int {
 	args = c.Meta.process(args)
 	envCommandShowWarning(c.Ui, c.LegacyName)
 
+	var jsonOutput bool
 	cmdFlags := c.Meta.defaultFlagSet("workspace list")
 	c.Meta.varFlagSet(cmdFlags)
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
@@ -68,13 +73,35 @@ func (c *WorkspaceListCommand) Run(args []string) int {
 	// This command will not write state
 	c.ignoreRemoteVersionConflict(b)
 
+	env, isOverridden := c.WorkspaceOverridden()
+
 	states, err := b.Workspaces()
-	if err != nil {
+	if errors.Is(err, backend.ErrWorkspacesNotSupported) {
+		// A backend that can't enumerate its workspaces has only the one
+		// we're currently using.
+		states = []string{env}
+	} else if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
 
-	env, isOverridden := c.WorkspaceOverridden()
+	if jsonOutput {
+		type workspace struct {
+			Name    string `json:"name"`
+			Current bool   `json:"current"`
+		}
+		workspaces := make([]workspace, 0, len(states))
+		for _, s := range states {
+			workspaces = append(workspaces, workspace{Name: s, Current: s == env})
+		}
+		out, err := json.MarshalIndent(workspaces, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal workspaces to JSON: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(out))
+		return 0
+	}
 
 	var out bytes.Buffer
 	for _, s := range states {
@@ -111,6 +138,10 @@ Usage: tofu [global options] workspace list [options]
 
 Options:
 
+  -json              Produce the list of workspaces as a JSON array of
+                     objects, each with the name of a workspace and
+                     whether it's the current one.
+
   -var 'foo=bar'     Set a value for one of the input variables in the root
                      module of the configuration. Use this option more than
                      once to set more than one variable.
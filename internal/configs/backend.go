@@ -7,6 +7,7 @@ package configs
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
@@ -14,6 +15,7 @@ import (
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 )
 
 // Backend represents a "backend" block inside a "terraform" block in a module
@@ -70,14 +72,63 @@ func (b *Backend) Hash(schema *configschema.Block) (int, hcl.Diagnostics) {
 	return toHash.Hash(), diags
 }
 
+// Decode evaluates the backend configuration against the given schema.
+//
+// In addition to the functions available everywhere in the language, the
+// backend configuration can call the "env" function to read the value of an
+// environment variable. That function is only available here, so that the
+// rest of the configuration can't come to depend on the environment of the
+// process running OpenTofu.
 func (b *Backend) Decode(schema *configschema.Block) (cty.Value, hcl.Diagnostics) {
-	return b.Eval.DecodeBlock(b.Config, schema.DecoderSpec(), StaticIdentifier{
+	parent := &hcl.EvalContext{
+		Functions: map[string]function.Function{
+			"env": backendEnvFunc(),
+		},
+	}
+	return b.Eval.DecodeBlockWithParent(parent, b.Config, schema.DecoderSpec(), StaticIdentifier{
 		Module:    addrs.RootModule,
 		Subject:   fmt.Sprintf("backend.%s", b.Type),
 		DeclRange: b.DeclRange,
 	})
 }
 
+// backendEnvFunc returns the "env" function for backend configurations, which
+// returns the value of the environment variable with the given name.
+//
+// If the environment variable isn't set then the function returns its
+// optional second argument, or fails if there isn't one. An environment
+// variable that's set to an empty string is returned as an empty string.
+func backendEnvFunc() function.Function {
+	return function.New(&function.Spec{
+		Description: "Returns the value of an environment variable, or the given default value if the environment variable isn't set.",
+		Params: []function.Parameter{
+			{
+				Name: "name",
+				Type: cty.String,
+			},
+		},
+		VarParam: &function.Parameter{
+			Name: "default",
+			Type: cty.String,
+		},
+		Type:         function.StaticReturnType(cty.String),
+		RefineResult: func(rb *cty.RefinementBuilder) *cty.RefinementBuilder { return rb.NotNull() },
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			if len(args) > 2 {
+				return cty.NilVal, function.NewArgErrorf(2, "too many arguments; only one default value is allowed")
+			}
+			name := args[0].AsString()
+			if val, ok := os.LookupEnv(name); ok {
+				return cty.StringVal(val), nil
+			}
+			if len(args) == 2 {
+				return args[1], nil
+			}
+			return cty.NilVal, function.NewArgErrorf(0, "the environment variable %q is not set; set it, or add a default value as the second argument", name)
+		},
+	})
+}
+
 // This is a hack that may not be needed, but preserves the idea that invalid backends will show a cryptic error about running init during plan/apply startup.
 func (b *Backend) referenceDiagnostics(schema *configschema.Block) hcl.Diagnostics {
 	var diags hcl.Diagnostics
@@ -112,6 +112,10 @@ type File struct {
 	Removed []*Removed
 
 	Checks []*Check
+
+	// tofuBlocks holds the settings from any "tofu" blocks in the file
+	// until they are resolved by resolveTofuBlocks.
+	tofuBlocks *settingsBlockContent
 }
 
 // SelectiveLoader allows the consumer to only load and validate the portions of files needed for the given operations/contexts
@@ -140,8 +144,19 @@ func (s SelectiveLoader) filter(input []*File) []*File {
 		case SelectiveLoadBackend:
 			outFile.Backends = inFile.Backends
 			outFile.CloudConfigs = inFile.CloudConfigs
+			if inFile.tofuBlocks != nil {
+				outFile.tofuBlocks = &settingsBlockContent{
+					Backends:     inFile.tofuBlocks.Backends,
+					CloudConfigs: inFile.tofuBlocks.CloudConfigs,
+				}
+			}
 		case SelectiveLoadEncryption:
 			outFile.Encryptions = inFile.Encryptions
+			if inFile.tofuBlocks != nil {
+				outFile.tofuBlocks = &settingsBlockContent{
+					Encryptions: inFile.tofuBlocks.Encryptions,
+				}
+			}
 		}
 		out[i] = outFile
 	}
@@ -187,6 +202,14 @@ func NewModule(primaryFiles, overrideFiles []*File, call StaticModuleCall, sourc
 	primaryFiles = load.filter(primaryFiles)
 	overrideFiles = load.filter(overrideFiles)
 
+	// Settings in "tofu" blocks override the same settings in "terraform"
+	// blocks across the whole module, but override files are each resolved
+	// separately because they are merged one at a time below.
+	diags = append(diags, resolveTofuBlocks(primaryFiles)...)
+	for _, file := range overrideFiles {
+		diags = append(diags, resolveTofuBlocks([]*File{file})...)
+	}
+
 	// Process the required_providers blocks first, to ensure that all
 	// resources have access to the correct provider FQNs
 	for _, file := range primaryFiles {
@@ -220,6 +220,9 @@ func (r *Resource) merge(or *Resource, rps map[string]*RequiredProvider) hcl.Dia
 	if or.ForEach != nil {
 		r.ForEach = or.ForEach
 	}
+	if or.ForEachKeysHashed {
+		r.ForEachKeysHashed = true
+	}
 
 	if or.ProviderConfigRef != nil {
 		r.ProviderConfigRef = or.ProviderConfigRef
@@ -6,9 +6,12 @@
 package configs
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -418,3 +421,47 @@ func TestModule_cloud_duplicate_overrides(t *testing.T) {
 		t.Fatalf("expected module error to contain %q\nerror was:\n%s", want, got)
 	}
 }
+
+func TestModule_tofu_block_overrides(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/tofu-block-override")
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	var gotWarnings []string
+	for _, diag := range diags {
+		gotWarnings = append(gotWarnings, fmt.Sprintf("%s: %s", diag.Subject.Filename, diag.Detail))
+	}
+	wantWarnings := []string{
+		`testdata/tofu-block-override/terraform.tf: The backend "s3" setting in this "terraform" block is overridden by the same setting in the "tofu" block at testdata/tofu-block-override/tofu.tf:14,3-18, so OpenTofu will ignore it.`,
+		`testdata/tofu-block-override/terraform.tf: The required_providers entry "aws" setting in this "terraform" block is overridden by the same setting in the "tofu" block at testdata/tofu-block-override/tofu.tf:5,11-8,6, so OpenTofu will ignore it.`,
+	}
+	if diff := cmp.Diff(wantWarnings, gotWarnings); diff != "" {
+		t.Errorf("wrong warnings\n%s", diff)
+	}
+
+	if got, want := mod.Backend.Type, "local"; got != want {
+		t.Errorf("wrong backend type %q; want %q", got, want)
+	}
+
+	gotProviders := map[string]string{}
+	for name, rp := range mod.ProviderRequirements.RequiredProviders {
+		gotProviders[name] = rp.Type.String()
+	}
+	wantProviders := map[string]string{
+		"aws":    "registry.opentofu.org/opentofu/aws",
+		"null":   "registry.opentofu.org/hashicorp/null",
+		"random": "registry.opentofu.org/hashicorp/random",
+	}
+	if diff := cmp.Diff(wantProviders, gotProviders); diff != "" {
+		t.Errorf("wrong required providers\n%s", diff)
+	}
+	if got, want := mod.ProviderRequirements.RequiredProviders["aws"].Requirement.Required.String(), "~> 5.0"; got != want {
+		t.Errorf("wrong aws version constraint %q; want %q", got, want)
+	}
+
+	// The required_version constraints from both blocks must all be met.
+	if got, want := len(mod.CoreVersionConstraints), 2; got != want {
+		t.Errorf("wrong number of core version constraints %d; want %d", got, want)
+	}
+}
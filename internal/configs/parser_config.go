@@ -75,7 +75,7 @@ func (p *Parser) loadConfigFile(path string, override bool) (*File, hcl.Diagnost
 	for _, block := range content.Blocks {
 		switch block.Type {
 
-		case "terraform":
+		case "terraform", "tofu":
 			content, contentDiags := block.Body.Content(terraformBlockSchema)
 			diags = append(diags, contentDiags...)
 
@@ -83,6 +83,8 @@ func (p *Parser) loadConfigFile(path string, override bool) (*File, hcl.Diagnost
 			// attributes here because sniffCoreVersionRequirements and
 			// sniffActiveExperiments already dealt with those above.
 
+			settings := &settingsBlockContent{}
+
 			for _, innerBlock := range content.Blocks {
 				switch innerBlock.Type {
 
@@ -90,33 +92,33 @@ func (p *Parser) loadConfigFile(path string, override bool) (*File, hcl.Diagnost
 					backendCfg, cfgDiags := decodeBackendBlock(innerBlock)
 					diags = append(diags, cfgDiags...)
 					if backendCfg != nil {
-						file.Backends = append(file.Backends, backendCfg)
+						settings.Backends = append(settings.Backends, backendCfg)
 					}
 
 				case "cloud":
 					cloudCfg, cfgDiags := decodeCloudBlock(innerBlock)
 					diags = append(diags, cfgDiags...)
 					if cloudCfg != nil {
-						file.CloudConfigs = append(file.CloudConfigs, cloudCfg)
+						settings.CloudConfigs = append(settings.CloudConfigs, cloudCfg)
 					}
 
 				case "required_providers":
 					reqs, reqsDiags := decodeRequiredProvidersBlock(innerBlock)
 					diags = append(diags, reqsDiags...)
-					file.RequiredProviders = append(file.RequiredProviders, reqs)
+					settings.RequiredProviders = append(settings.RequiredProviders, reqs)
 
 				case "provider_meta":
 					providerCfg, cfgDiags := decodeProviderMetaBlock(innerBlock)
 					diags = append(diags, cfgDiags...)
 					if providerCfg != nil {
-						file.ProviderMetas = append(file.ProviderMetas, providerCfg)
+						settings.ProviderMetas = append(settings.ProviderMetas, providerCfg)
 					}
 
 				case "encryption":
 					encryptionCfg, cfgDiags := config.DecodeConfig(innerBlock.Body, innerBlock.DefRange)
 					diags = append(diags, cfgDiags...)
 					if encryptionCfg != nil {
-						file.Encryptions = append(file.Encryptions, encryptionCfg)
+						settings.Encryptions = append(settings.Encryptions, encryptionCfg)
 					}
 
 				default:
@@ -127,6 +129,18 @@ func (p *Parser) loadConfigFile(path string, override bool) (*File, hcl.Diagnost
 				}
 			}
 
+			// Settings from "tofu" blocks are kept separately until the
+			// module is assembled, because they take precedence over the
+			// same settings in "terraform" blocks. See resolveTofuBlocks.
+			if block.Type == "tofu" {
+				if file.tofuBlocks == nil {
+					file.tofuBlocks = &settingsBlockContent{}
+				}
+				file.tofuBlocks.append(settings)
+			} else {
+				file.appendSettings(settings)
+			}
+
 		case "required_providers":
 			// required_providers should be nested inside a "terraform" block
 			diags = append(diags, &hcl.Diagnostic{
@@ -223,8 +237,9 @@ func (p *Parser) loadConfigFile(path string, override bool) (*File, hcl.Diagnost
 }
 
 // sniffCoreVersionRequirements does minimal parsing of the given body for
-// "terraform" blocks with "required_version" attributes, returning the
-// requirements found.
+// "terraform" and "tofu" blocks with "required_version" attributes, returning
+// the requirements found. All of the returned constraints must be satisfied,
+// so constraints from both block types are effectively intersected.
 //
 // This is intended to maximize the chance that we'll be able to read the
 // requirements (syntax errors notwithstanding) even if the config file contains
@@ -265,6 +280,9 @@ var configFileSchema = &hcl.BodySchema{
 		{
 			Type: "terraform",
 		},
+		{
+			Type: "tofu",
+		},
 		{
 			// This one is not really valid, but we include it here so we
 			// can create a specialized error message hinting the user to
@@ -314,8 +332,8 @@ var configFileSchema = &hcl.BodySchema{
 	},
 }
 
-// terraformBlockSchema is the schema for a top-level "terraform" block in
-// a configuration file.
+// terraformBlockSchema is the schema for a top-level "terraform" or "tofu"
+// block in a configuration file.
 var terraformBlockSchema = &hcl.BodySchema{
 	Attributes: []hcl.AttributeSchema{
 		{Name: "required_version"},
@@ -350,6 +368,9 @@ var configFileTerraformBlockSniffRootSchema = &hcl.BodySchema{
 		{
 			Type: "terraform",
 		},
+		{
+			Type: "tofu",
+		},
 	},
 }
 
@@ -134,6 +134,16 @@ func TestParserLoadConfigFileFailureMessages(t *testing.T) {
 			hcl.DiagError,
 			"Unsuitable value type",
 		},
+		{
+			"invalid-files/resource-lifecycle-for-each-keys.tf",
+			hcl.DiagError,
+			"Invalid for_each_keys value",
+		},
+		{
+			"invalid-files/resource-read-triggered-by.tf",
+			hcl.DiagError,
+			"Invalid managed resource lifecycle argument",
+		},
 	}
 
 	for _, test := range tests {
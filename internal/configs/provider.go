@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/instances"
@@ -370,6 +371,7 @@ func (p *ProviderBlock) generateForEachProviders(eval *StaticEvaluator) ([]*Prov
 			ProviderCommon: p.ProviderCommon,
 			Alias:          k,
 			InstanceData: instances.RepetitionData{
+				EachKey:   cty.StringVal(k),
 				EachValue: v,
 			},
 		})
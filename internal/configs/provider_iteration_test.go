@@ -6,8 +6,11 @@
 package configs
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/opentofu/opentofu/internal/addrs"
 )
 
@@ -74,3 +77,77 @@ func TestNewModule_provider_invalid_name(t *testing.T) {
 		t.Fatalf("incorrect number of providers: got %d, expected: %d", len(mod.ProviderConfigs), 0)
 	}
 }
+
+func TestNewModule_provider_foreach_reference(t *testing.T) {
+	mod, diags := testModuleFromDir("testdata/providers_foreach_reference")
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	for _, alias := range []string{"east", "west"} {
+		p, found := mod.GetProviderConfig("test", alias)
+		if !found {
+			t.Fatalf("unable to find %s provider", alias)
+		}
+		if got, want := p.InstanceData.EachKey, cty.StringVal(alias); !got.RawEquals(want) {
+			t.Errorf("wrong each.key for %s provider: got %#v, want %#v", alias, got, want)
+		}
+	}
+
+	for name, want := range map[string]string{"a": "east", "b": "west"} {
+		r := mod.ManagedResources["test_thing."+name]
+		if got := r.ProviderConfigRef.Alias; got != want {
+			t.Errorf("wrong provider alias for test_thing.%s: got %q, want %q", name, got, want)
+		}
+	}
+
+	mc := mod.ModuleCalls["child"]
+	if len(mc.Providers) != 1 {
+		t.Fatalf("wrong number of providers passed to module: %d", len(mc.Providers))
+	}
+	if got, want := mc.Providers[0].InParent.Alias, "west"; got != want {
+		t.Errorf("wrong provider alias passed to module: got %q, want %q", got, want)
+	}
+}
+
+func TestNewModule_provider_foreach_invalidReference(t *testing.T) {
+	tests := map[string]struct {
+		ref    string
+		detail string
+	}{
+		"number": {
+			`test[0]`,
+			"The instance key of a provider configuration must be a string.",
+		},
+		"invalid alias": {
+			`test["1a"]`,
+			`Instance key "1a" is not a valid provider configuration alias.`,
+		},
+		"nested": {
+			`test.east["a"]`,
+			"The provider argument requires a provider type name, optionally followed by a period and then a configuration alias, or by an instance key in brackets.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parser := testParser(map[string]string{
+				"main.tf": `
+resource "test_thing" "a" {
+  provider = ` + test.ref + `
+}
+`,
+			})
+			_, diags := parser.LoadConfigFile("main.tf")
+			if !diags.HasErrors() {
+				t.Fatal("expected error")
+			}
+			if got, want := diags[0].Summary, "Invalid provider configuration reference"; got != want {
+				t.Errorf("wrong summary: got %q, want %q", got, want)
+			}
+			if got := diags[0].Detail; !strings.HasPrefix(got, test.detail) {
+				t.Errorf("wrong detail: got %q, want prefix %q", got, test.detail)
+			}
+		})
+	}
+}
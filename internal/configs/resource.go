@@ -39,6 +39,17 @@ type Resource struct {
 
 	TriggersReplacement []hcl.Expression
 
+	// ForEachKeysHashed is set by the lifecycle argument for_each_keys = "hash",
+	// and causes the instances of a resource using for_each over a set to be
+	// keyed by a stable hash of each element rather than the element itself.
+	ForEachKeysHashed bool
+
+	// TriggersRead is populated only for Mode = addrs.DataResourceMode,
+	// containing the read_triggered_by expressions that force the data
+	// source to be read again during apply when the referenced resources
+	// have planned changes.
+	TriggersRead []hcl.Expression
+
 	// Managed is populated only for Mode = addrs.ManagedResourceMode,
 	// containing the additional fields that apply to managed resources.
 	// For all other resource modes, this field is nil.
@@ -211,12 +222,27 @@ func decodeResourceBlock(block *hcl.Block, override bool) (*Resource, hcl.Diagno
 			}
 
 			if attr, exists := lcContent.Attributes["replace_triggered_by"]; exists {
-				exprs, hclDiags := decodeReplaceTriggeredBy(attr.Expr)
+				exprs, hclDiags := decodeTriggeredBy(attr.Expr, "replace_triggered_by")
 				diags = diags.Extend(hclDiags)
 
 				r.TriggersReplacement = append(r.TriggersReplacement, exprs...)
 			}
 
+			if attr, exists := lcContent.Attributes["for_each_keys"]; exists {
+				var keysDiags hcl.Diagnostics
+				r.ForEachKeysHashed, keysDiags = decodeForEachKeys(attr)
+				diags = append(diags, keysDiags...)
+			}
+
+			if attr, exists := lcContent.Attributes["read_triggered_by"]; exists {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid managed resource lifecycle argument",
+					Detail:   "The lifecycle argument \"read_triggered_by\" is defined only for data resources (\"data\" blocks), and is not valid for managed resources. Use \"replace_triggered_by\" to replace a managed resource when another resource changes.",
+					Subject:  attr.NameRange.Ptr(),
+				})
+			}
+
 			if attr, exists := lcContent.Attributes["ignore_changes"]; exists {
 
 				// ignore_changes can either be a list of relative traversals
@@ -498,10 +524,23 @@ func decodeDataBlock(block *hcl.Block, override, nested bool) (*Resource, hcl.Di
 			lcContent, lcDiags := block.Body.Content(resourceLifecycleBlockSchema)
 			diags = append(diags, lcDiags...)
 
-			// All of the attributes defined for resource lifecycle are for
-			// managed resources only, so we can emit a common error message
-			// for any given attributes that HCL accepted.
+			// All of the other attributes defined for resource lifecycle are
+			// for managed resources only, so we can emit a common error
+			// message for any given attributes that HCL accepted.
 			for name, attr := range lcContent.Attributes {
+				switch name {
+				case "read_triggered_by":
+					exprs, hclDiags := decodeTriggeredBy(attr.Expr, name)
+					diags = diags.Extend(hclDiags)
+
+					r.TriggersRead = append(r.TriggersRead, exprs...)
+					continue
+				case "for_each_keys":
+					var keysDiags hcl.Diagnostics
+					r.ForEachKeysHashed, keysDiags = decodeForEachKeys(attr)
+					diags = append(diags, keysDiags...)
+					continue
+				}
 				diags = append(diags, &hcl.Diagnostic{
 					Severity: hcl.DiagError,
 					Summary:  "Invalid data resource lifecycle argument",
@@ -547,11 +586,37 @@ func decodeDataBlock(block *hcl.Block, override, nested bool) (*Resource, hcl.Di
 	return r, diags
 }
 
-// decodeReplaceTriggeredBy decodes and does basic validation of the
-// replace_triggered_by expressions, ensuring they only contains references to
-// a single resource, and the only extra variables are count.index or each.key.
-func decodeReplaceTriggeredBy(expr hcl.Expression) ([]hcl.Expression, hcl.Diagnostics) {
-	// Since we are manually parsing the replace_triggered_by argument, we
+// decodeForEachKeys decodes the lifecycle for_each_keys argument, returning
+// true if instance keys should be hashed.
+func decodeForEachKeys(attr *hcl.Attribute) (bool, hcl.Diagnostics) {
+	var mode string
+	diags := gohcl.DecodeExpression(attr.Expr, nil, &mode)
+	if diags.HasErrors() {
+		return false, diags
+	}
+
+	switch mode {
+	case "value":
+		return false, diags
+	case "hash":
+		return true, diags
+	default:
+		return false, append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid for_each_keys value",
+			Detail:   `The for_each_keys argument must be either "value", to use each set element as its instance key, or "hash", to use a hash of each set element.`,
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+	}
+}
+
+// decodeTriggeredBy decodes and does basic validation of the
+// replace_triggered_by and read_triggered_by expressions, ensuring they only
+// contains references to a single resource, and the only extra variables are
+// count.index or each.key. argName is the name of the argument being decoded,
+// for use in error messages.
+func decodeTriggeredBy(expr hcl.Expression, argName string) ([]hcl.Expression, hcl.Diagnostics) {
+	// Since we are manually parsing the triggered_by argument, we
 	// need to specially handle json configs, in which case the values will
 	// be json strings rather than hcl. To simplify parsing however we will
 	// decode the individual list elements, rather than the entire expression.
@@ -602,8 +667,8 @@ func decodeReplaceTriggeredBy(expr hcl.Expression) ([]hcl.Expression, hcl.Diagno
 				if sub.Name != "key" {
 					diags = append(diags, &hcl.Diagnostic{
 						Severity: hcl.DiagError,
-						Summary:  "Invalid each reference in replace_triggered_by expression",
-						Detail:   "Only each.key may be used in replace_triggered_by.",
+						Summary:  fmt.Sprintf("Invalid each reference in %s expression", argName),
+						Detail:   fmt.Sprintf("Only each.key may be used in %s.", argName),
 						Subject:  expr.Range().Ptr(),
 					})
 				}
@@ -611,8 +676,8 @@ func decodeReplaceTriggeredBy(expr hcl.Expression) ([]hcl.Expression, hcl.Diagno
 				if sub.Name != "index" {
 					diags = append(diags, &hcl.Diagnostic{
 						Severity: hcl.DiagError,
-						Summary:  "Invalid count reference in replace_triggered_by expression",
-						Detail:   "Only count.index may be used in replace_triggered_by.",
+						Summary:  fmt.Sprintf("Invalid count reference in %s expression", argName),
+						Detail:   fmt.Sprintf("Only count.index may be used in %s.", argName),
 						Subject:  expr.Range().Ptr(),
 					})
 				}
@@ -620,8 +685,8 @@ func decodeReplaceTriggeredBy(expr hcl.Expression) ([]hcl.Expression, hcl.Diagno
 				// everything else should be simple traversals
 				diags = append(diags, &hcl.Diagnostic{
 					Severity: hcl.DiagError,
-					Summary:  "Invalid reference in replace_triggered_by expression",
-					Detail:   "Only resources, count.index, and each.key may be used in replace_triggered_by.",
+					Summary:  fmt.Sprintf("Invalid reference in %s expression", argName),
+					Detail:   fmt.Sprintf("Only resources, count.index, and each.key may be used in %s.", argName),
 					Subject:  expr.Range().Ptr(),
 				})
 			}
@@ -631,15 +696,15 @@ func decodeReplaceTriggeredBy(expr hcl.Expression) ([]hcl.Expression, hcl.Diagno
 		case resourceCount == 0:
 			diags = append(diags, &hcl.Diagnostic{
 				Severity: hcl.DiagError,
-				Summary:  "Invalid replace_triggered_by expression",
-				Detail:   "Missing resource reference in replace_triggered_by expression.",
+				Summary:  fmt.Sprintf("Invalid %s expression", argName),
+				Detail:   fmt.Sprintf("Missing resource reference in %s expression.", argName),
 				Subject:  expr.Range().Ptr(),
 			})
 		case resourceCount > 1:
 			diags = append(diags, &hcl.Diagnostic{
 				Severity: hcl.DiagError,
-				Summary:  "Invalid replace_triggered_by expression",
-				Detail:   "Multiple resource references in replace_triggered_by expression.",
+				Summary:  fmt.Sprintf("Invalid %s expression", argName),
+				Detail:   fmt.Sprintf("Multiple resource references in %s expression.", argName),
 				Subject:  expr.Range().Ptr(),
 			})
 		}
@@ -696,7 +761,7 @@ func decodeProviderConfigRef(expr hcl.Expression, argName string) (*ProviderConf
 		diags = append(diags, &hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  "Invalid provider configuration reference",
-			Detail:   fmt.Sprintf("The %s argument requires a provider type name, optionally followed by a period and then a configuration alias.", argName),
+			Detail:   fmt.Sprintf("The %s argument requires a provider type name, optionally followed by a period and then a configuration alias, or by an instance key in brackets.", argName),
 			Subject:  expr.Range().Ptr(),
 		})
 		return nil, diags
@@ -716,19 +781,45 @@ func decodeProviderConfigRef(expr hcl.Expression, argName string) (*ProviderConf
 	}
 
 	if len(traversal) > 1 {
-		aliasStep, ok := traversal[1].(hcl.TraverseAttr)
-		if !ok {
+		switch step := traversal[1].(type) {
+		case hcl.TraverseAttr:
+			ret.Alias = step.Name
+			ret.AliasRange = step.SourceRange().Ptr()
+		case hcl.TraverseIndex:
+			// A provider block using for_each declares one configuration per
+			// element, using each key as its alias, and so those
+			// configurations can also be referred to by instance key, as in
+			// aws["us-east-1"].
+			if step.Key.Type() != cty.String || !step.Key.IsKnown() || step.Key.IsNull() {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid provider configuration reference",
+					Detail:   "The instance key of a provider configuration must be a string.",
+					Subject:  step.SourceRange().Ptr(),
+				})
+				return ret, diags
+			}
+			key := step.Key.AsString()
+			if !hclsyntax.ValidIdentifier(key) {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid provider configuration reference",
+					Detail:   fmt.Sprintf("Instance key %q is not a valid provider configuration alias. %s", key, badIdentifierDetail),
+					Subject:  step.SourceRange().Ptr(),
+				})
+				return ret, diags
+			}
+			ret.Alias = key
+			ret.AliasRange = step.SourceRange().Ptr()
+		default:
 			diags = append(diags, &hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  "Invalid provider configuration reference",
-				Detail:   "Provider name must either stand alone or be followed by a period and then a configuration alias.",
+				Detail:   "Provider name must either stand alone or be followed by a period and then a configuration alias, or by an instance key in brackets.",
 				Subject:  traversal[1].SourceRange().Ptr(),
 			})
 			return ret, diags
 		}
-
-		ret.Alias = aliasStep.Name
-		ret.AliasRange = aliasStep.SourceRange().Ptr()
 	}
 
 	return ret, diags
@@ -814,6 +905,12 @@ var resourceLifecycleBlockSchema = &hcl.BodySchema{
 		{
 			Name: "replace_triggered_by",
 		},
+		{
+			Name: "read_triggered_by",
+		},
+		{
+			Name: "for_each_keys",
+		},
 	},
 	Blocks: []hcl.BlockHeaderSchema{
 		{Type: "precondition"},
@@ -108,6 +108,12 @@ func (s StaticEvaluator) DecodeExpression(expr hcl.Expression, ident StaticIdent
 }
 
 func (s StaticEvaluator) DecodeBlock(body hcl.Body, spec hcldec.Spec, ident StaticIdentifier) (cty.Value, hcl.Diagnostics) {
+	return s.DecodeBlockWithParent(nil, body, spec, ident)
+}
+
+// DecodeBlockWithParent is like DecodeBlock, but the given parent context can
+// contribute additional functions and variables to the evaluation.
+func (s StaticEvaluator) DecodeBlockWithParent(parent *hcl.EvalContext, body hcl.Body, spec hcldec.Spec, ident StaticIdentifier) (cty.Value, hcl.Diagnostics) {
 	var diags hcl.Diagnostics
 
 	refs, refsDiags := lang.References(addrs.ParseRef, hcldec.Variables(body, spec))
@@ -116,7 +122,7 @@ func (s StaticEvaluator) DecodeBlock(body hcl.Body, spec hcldec.Spec, ident Stat
 		return cty.DynamicVal, diags
 	}
 
-	ctx, ctxDiags := s.scope(ident).EvalContext(refs)
+	ctx, ctxDiags := s.scope(ident).EvalContextWithParent(parent, refs)
 	diags = append(diags, ctxDiags.ToHCL()...)
 	if diags.HasErrors() {
 		return cty.DynamicVal, diags
@@ -395,3 +395,94 @@ terraform {
 		})
 	}
 }
+
+func TestBackend_DecodeEnv(t *testing.T) {
+	t.Setenv("TF_TEST_BACKEND_BUCKET", "my-bucket")
+	t.Setenv("TF_TEST_BACKEND_EMPTY", "")
+
+	cases := []struct {
+		ident string
+		body  string
+		want  cty.Value
+		diags []string
+	}{{
+		ident: "set",
+		body: `
+terraform {
+	backend "set" {
+		thing = env("TF_TEST_BACKEND_BUCKET")
+	}
+}`,
+		want: cty.StringVal("my-bucket"),
+	}, {
+		ident: "empty",
+		body: `
+terraform {
+	backend "empty" {
+		thing = env("TF_TEST_BACKEND_EMPTY", "default")
+	}
+}`,
+		want: cty.StringVal(""),
+	}, {
+		ident: "default",
+		body: `
+terraform {
+	backend "default" {
+		thing = env("TF_TEST_BACKEND_UNSET", "default")
+	}
+}`,
+		want: cty.StringVal("default"),
+	}, {
+		ident: "unset",
+		body: `
+terraform {
+	backend "unset" {
+		thing = env("TF_TEST_BACKEND_UNSET")
+	}
+}`,
+		diags: []string{`eval.tf:4,16-37: Invalid function argument; Invalid value for "name" parameter: the environment variable "TF_TEST_BACKEND_UNSET" is not set; set it, or add a default value as the second argument.`},
+	}, {
+		ident: "local",
+		body: `
+locals {
+	bucket = env("TF_TEST_BACKEND_BUCKET")
+}
+
+terraform {
+	backend "local" {
+		thing = local.bucket
+	}
+}`,
+		diags: []string{
+			`eval.tf:3,11-14: Call to unknown function; There is no function named "env". Did you mean "one"?`,
+			`eval.tf:7,2-17: Unable to compute static value; backend.local depends on local.bucket which is not available`,
+		},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.ident, func(t *testing.T) {
+			parser := testParser(map[string]string{"eval.tf": tc.body})
+			file, fileDiags := parser.LoadConfigFile("eval.tf")
+			if fileDiags.HasErrors() {
+				t.Fatal(fileDiags)
+			}
+
+			mod, _ := NewModule([]*File{file}, nil, RootModuleCallForTesting(), "dir", SelectiveLoadAll)
+			got, diags := mod.Backend.Decode(&configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"thing": {
+						Type:     cty.String,
+						Optional: true,
+					},
+				},
+			})
+
+			if assertExactDiagnostics(t, diags, tc.diags) || tc.diags != nil {
+				return
+			}
+			if want := cty.ObjectVal(map[string]cty.Value{"thing": tc.want}); !got.RawEquals(want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+			}
+		})
+	}
+}
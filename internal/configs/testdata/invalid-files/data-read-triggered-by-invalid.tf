@@ -0,0 +1,6 @@
+data "test_data_source" "a" {
+  lifecycle {
+    # must refer to a single resource
+    read_triggered_by = [ var.input ]
+  }
+}
@@ -0,0 +1,6 @@
+resource "test_resource" "a" {
+  for_each = var.input
+  lifecycle {
+    for_each_keys = "sorted"
+  }
+}
@@ -0,0 +1,6 @@
+resource "test_resource" "a" {
+  lifecycle {
+    # read_triggered_by is only valid for data resources
+    read_triggered_by = [ test_resource.b ]
+  }
+}
@@ -0,0 +1,23 @@
+locals {
+  regions = toset(["east", "west"])
+}
+
+provider "test" {
+  for_each = local.regions
+  region   = each.key
+}
+
+resource "test_thing" "a" {
+  provider = test["east"]
+}
+
+resource "test_thing" "b" {
+  provider = test.west
+}
+
+module "child" {
+  source = "./child"
+  providers = {
+    test = test["west"]
+  }
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_version = ">= 1.0.0"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 4.0"
+    }
+    null = {
+      source = "hashicorp/null"
+    }
+  }
+
+  backend "s3" {
+  }
+}
@@ -0,0 +1,16 @@
+tofu {
+  required_version = "< 2.0.0"
+
+  required_providers {
+    aws = {
+      source  = "opentofu/aws"
+      version = "~> 5.0"
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+
+  backend "local" {
+  }
+}
@@ -13,3 +13,16 @@ data "http" "example2" {
     data.http.example1,
   ]
 }
+
+resource "null_resource" "example" {
+}
+
+data "http" "example3" {
+  url = "http://example.com/"
+
+  lifecycle {
+    read_triggered_by = [
+      null_resource.example.id,
+    ]
+  }
+}
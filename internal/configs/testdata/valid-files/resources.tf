@@ -47,3 +47,11 @@ resource "aws_instance" "depends" {
     replace_triggered_by = [ aws_instance.web[1], aws_security_group.firewall.id ]
   }
 }
+
+resource "aws_instance" "hashed" {
+  for_each = toset([{ name = "a" }, { name = "b" }])
+
+  lifecycle {
+    for_each_keys = "hash"
+  }
+}
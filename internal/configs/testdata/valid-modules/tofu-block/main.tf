@@ -0,0 +1,13 @@
+tofu {
+  required_providers {
+    null = {
+      source = "hashicorp/null"
+    }
+  }
+
+  backend "local" {
+  }
+}
+
+resource "null_resource" "example" {
+}
@@ -0,0 +1,193 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/opentofu/opentofu/internal/encryption/config"
+)
+
+// settingsBlockContent is the content of a single top-level "terraform" or
+// "tofu" block in a configuration file.
+type settingsBlockContent struct {
+	Backends          []*Backend
+	CloudConfigs      []*CloudConfig
+	ProviderMetas     []*ProviderMeta
+	RequiredProviders []*RequiredProviders
+	Encryptions       []*config.EncryptionConfig
+}
+
+func (s *settingsBlockContent) append(other *settingsBlockContent) {
+	s.Backends = append(s.Backends, other.Backends...)
+	s.CloudConfigs = append(s.CloudConfigs, other.CloudConfigs...)
+	s.ProviderMetas = append(s.ProviderMetas, other.ProviderMetas...)
+	s.RequiredProviders = append(s.RequiredProviders, other.RequiredProviders...)
+	s.Encryptions = append(s.Encryptions, other.Encryptions...)
+}
+
+func (f *File) appendSettings(s *settingsBlockContent) {
+	f.Backends = append(f.Backends, s.Backends...)
+	f.CloudConfigs = append(f.CloudConfigs, s.CloudConfigs...)
+	f.ProviderMetas = append(f.ProviderMetas, s.ProviderMetas...)
+	f.RequiredProviders = append(f.RequiredProviders, s.RequiredProviders...)
+	f.Encryptions = append(f.Encryptions, s.Encryptions...)
+}
+
+// resolveTofuBlocks combines the settings from the "tofu" blocks in the given
+// files with those from the "terraform" blocks, so that the rest of the
+// module loading logic only needs to deal with one set of settings.
+//
+// A module may declare the same setting in both a "terraform" block and a
+// "tofu" block, for example so that it can be used with both Terraform and
+// OpenTofu. The setting from the "tofu" block then takes precedence, and the
+// setting from the "terraform" block is discarded with a warning:
+//
+//   - A "backend" or "cloud" block in a "tofu" block replaces any "backend"
+//     and "cloud" blocks in "terraform" blocks.
+//   - An "encryption" block in a "tofu" block replaces any "encryption" block
+//     in "terraform" blocks.
+//   - Each "provider_meta" block and each entry in a "required_providers"
+//     block replaces the one for the same provider in "terraform" blocks.
+//
+// The "required_version" constraints from both block types are all retained
+// by sniffCoreVersionRequirements, and so must all be satisfied.
+//
+// After this function returns, the tofuBlocks field of each file is nil.
+func resolveTofuBlocks(files []*File) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	var tofu settingsBlockContent
+	found := false
+	for _, file := range files {
+		if file.tofuBlocks != nil {
+			tofu.append(file.tofuBlocks)
+			found = true
+		}
+	}
+	if !found {
+		return diags
+	}
+
+	if len(tofu.Backends) != 0 || len(tofu.CloudConfigs) != 0 {
+		var tofuRange hcl.Range
+		if len(tofu.Backends) != 0 {
+			tofuRange = tofu.Backends[0].DeclRange
+		} else {
+			tofuRange = tofu.CloudConfigs[0].DeclRange
+		}
+		for _, file := range files {
+			for _, b := range file.Backends {
+				diags = append(diags, overriddenSettingDiagnostic(fmt.Sprintf("backend %q", b.Type), b.DeclRange, tofuRange))
+			}
+			for _, c := range file.CloudConfigs {
+				diags = append(diags, overriddenSettingDiagnostic("cloud", c.DeclRange, tofuRange))
+			}
+			file.Backends = nil
+			file.CloudConfigs = nil
+		}
+	}
+
+	if len(tofu.Encryptions) != 0 {
+		tofuRange := tofu.Encryptions[0].DeclRange
+		for _, file := range files {
+			for _, e := range file.Encryptions {
+				diags = append(diags, overriddenSettingDiagnostic("encryption", e.DeclRange, tofuRange))
+			}
+			file.Encryptions = nil
+		}
+	}
+
+	if len(tofu.ProviderMetas) != 0 {
+		tofuMetas := make(map[string]*ProviderMeta, len(tofu.ProviderMetas))
+		for _, pm := range tofu.ProviderMetas {
+			if _, exists := tofuMetas[pm.Provider]; !exists {
+				tofuMetas[pm.Provider] = pm
+			}
+		}
+		for _, file := range files {
+			var kept []*ProviderMeta
+			for _, pm := range file.ProviderMetas {
+				if override, exists := tofuMetas[pm.Provider]; exists {
+					diags = append(diags, overriddenSettingDiagnostic(fmt.Sprintf("provider_meta %q", pm.Provider), pm.DeclRange, override.DeclRange))
+					continue
+				}
+				kept = append(kept, pm)
+			}
+			file.ProviderMetas = kept
+		}
+	}
+
+	// Entries in "required_providers" blocks are merged individually. The
+	// entries from the first "tofu" required_providers block are moved into
+	// the first "terraform" required_providers block so that the module
+	// still ends up with only one such block, and any others are reported
+	// as duplicates in the usual way.
+	var merged *RequiredProviders
+	if len(tofu.RequiredProviders) != 0 && tofu.RequiredProviders[0] != nil {
+		var target *RequiredProviders
+		for _, file := range files {
+			for _, rp := range file.RequiredProviders {
+				if rp != nil && target == nil {
+					target = rp
+				}
+			}
+		}
+		if target != nil {
+			merged = tofu.RequiredProviders[0]
+			names := make([]string, 0, len(merged.RequiredProviders))
+			for name := range merged.RequiredProviders {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				override := merged.RequiredProviders[name]
+				for _, file := range files {
+					for _, existing := range file.RequiredProviders {
+						if existing == nil {
+							continue
+						}
+						if rp, exists := existing.RequiredProviders[name]; exists {
+							diags = append(diags, overriddenSettingDiagnostic(fmt.Sprintf("required_providers entry %q", name), rp.DeclRange, override.DeclRange))
+							delete(existing.RequiredProviders, name)
+						}
+					}
+				}
+				target.RequiredProviders[name] = override
+			}
+		}
+	}
+
+	for _, file := range files {
+		tofuBlocks := file.tofuBlocks
+		file.tofuBlocks = nil
+		if tofuBlocks == nil {
+			continue
+		}
+		var rps []*RequiredProviders
+		for _, rp := range tofuBlocks.RequiredProviders {
+			if rp != merged {
+				rps = append(rps, rp)
+			}
+		}
+		tofuBlocks.RequiredProviders = rps
+		file.appendSettings(tofuBlocks)
+	}
+
+	return diags
+}
+
+func overriddenSettingDiagnostic(setting string, overridden, override hcl.Range) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagWarning,
+		Summary:  `Setting overridden by "tofu" block`,
+		Detail:   fmt.Sprintf(`The %s setting in this "terraform" block is overridden by the same setting in the "tofu" block at %s, so OpenTofu will ignore it.`, setting, override),
+		Subject:  overridden.Ptr(),
+	}
+}
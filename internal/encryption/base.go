@@ -161,12 +161,29 @@ func (s *baseEncryption) decrypt(data []byte, validator func([]byte) error) ([]b
 	}
 
 	errs := make([]error, 0)
-	for _, method := range methods {
-		if unencrypted.Is(method) {
+	consumed := false
+	for _, m := range methods {
+		if unencrypted.Is(m) {
 			// Not applicable
 			continue
 		}
-		uncd, err := method.Decrypt(es.Data)
+		if consumed {
+			// The previous method decrypted the data in place, so we need a fresh copy of it.
+			es = basedata{}
+			if err := json.Unmarshal(data, &es); err != nil {
+				return nil, fmt.Errorf("invalid data format for decryption: %w", err)
+			}
+			consumed = false
+		}
+		var uncd []byte
+		if inPlace, ok := m.(method.InPlaceDecrypter); ok {
+			// The encrypted data was decoded into a buffer of its own, which we can reuse for the result to avoid
+			// holding two copies of a large state file in memory.
+			uncd, err = inPlace.DecryptInPlace(es.Data)
+			consumed = true
+		} else {
+			uncd, err = m.Decrypt(es.Data)
+		}
 		if err == nil {
 			// Success
 			return uncd, nil
@@ -0,0 +1,127 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider/compliancetest"
+)
+
+func TestKeyProvider(t *testing.T) {
+	command := helperCommand("ok")
+	hclCommand, err := json.Marshal(command)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compliancetest.ComplianceTest(
+		t,
+		compliancetest.TestConfiguration[*descriptor, *Config, *keyMeta, *keyProvider]{
+			Descriptor: New().(*descriptor),
+			HCLParseTestCases: map[string]compliancetest.HCLParseTestCase[*Config, *keyProvider]{
+				"success": {
+					HCL: fmt.Sprintf(`key_provider "external" "foo" {
+							command = %s
+						}`, hclCommand),
+					ValidHCL:   true,
+					ValidBuild: true,
+					Validate: func(config *Config, keyProvider *keyProvider) error {
+						if strings.Join(keyProvider.command, " ") != strings.Join(command, " ") {
+							return fmt.Errorf("incorrect command: %v", keyProvider.command)
+						}
+						return nil
+					},
+				},
+				"empty": {
+					HCL:        `key_provider "external" "foo" {}`,
+					ValidHCL:   false,
+					ValidBuild: false,
+				},
+				"empty-command": {
+					HCL: `key_provider "external" "foo" {
+							command = []
+						}`,
+					ValidHCL:   true,
+					ValidBuild: false,
+				},
+				"empty-program": {
+					HCL: `key_provider "external" "foo" {
+							command = ["", "foo"]
+						}`,
+					ValidHCL:   true,
+					ValidBuild: false,
+				},
+				"unknown-property": {
+					HCL: fmt.Sprintf(`key_provider "external" "foo" {
+							command = %s
+							unknown_property = "foo"
+						}`, hclCommand),
+					ValidHCL:   false,
+					ValidBuild: false,
+				},
+			},
+			ConfigStructTestCases: map[string]compliancetest.ConfigStructTestCase[*Config, *keyProvider]{
+				"success": {
+					Config: &Config{
+						Command: command,
+					},
+					ValidBuild: true,
+				},
+				"empty": {
+					Config:     &Config{},
+					ValidBuild: false,
+				},
+			},
+			MetadataStructTestCases: map[string]compliancetest.MetadataStructTestCase[*Config, *keyMeta]{
+				"empty": {
+					ValidConfig: &Config{
+						Command: command,
+					},
+					Meta:      &keyMeta{},
+					IsPresent: false,
+				},
+				"null": {
+					ValidConfig: &Config{
+						Command: command,
+					},
+					Meta:      &keyMeta{ExternalData: json.RawMessage("null")},
+					IsPresent: false,
+				},
+				"not-an-object": {
+					ValidConfig: &Config{
+						Command: command,
+					},
+					Meta:      &keyMeta{ExternalData: json.RawMessage("[1, 2, 3]")},
+					IsPresent: true,
+					IsValid:   false,
+				},
+				"valid": {
+					ValidConfig: &Config{
+						Command: command,
+					},
+					Meta:      &keyMeta{ExternalData: json.RawMessage(`{"key": "SGVsbG8gd29ybGQh"}`)},
+					IsPresent: true,
+					IsValid:   true,
+				},
+			},
+			ProvideTestCase: compliancetest.ProvideTestCase[*Config, *keyMeta]{
+				ValidConfig: &Config{
+					Command: command,
+				},
+				ValidateMetadata: func(meta *keyMeta) error {
+					if !meta.isPresent() {
+						return fmt.Errorf("metadata is empty")
+					}
+					return nil
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,27 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+type Config struct {
+	// Command is the program to run, followed by its arguments.
+	Command []string `hcl:"command"`
+}
+
+func (c Config) Build() (keyprovider.KeyProvider, keyprovider.KeyMeta, error) {
+	if len(c.Command) == 0 || c.Command[0] == "" {
+		return nil, nil, &keyprovider.ErrInvalidConfiguration{
+			Message: "the command must contain at least the program to run",
+		}
+	}
+
+	return &keyProvider{
+		command: c.Command,
+	}, new(keyMeta), nil
+}
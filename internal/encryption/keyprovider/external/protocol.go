@@ -0,0 +1,30 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"encoding/json"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+// request is the JSON object written to the standard input of the command.
+type request struct {
+	// ExternalData is the metadata the command returned when the data being
+	// decrypted was encrypted, or null if there is nothing to decrypt.
+	ExternalData json.RawMessage `json:"external_data"`
+}
+
+// response is the JSON object the command must write to its standard output.
+type response struct {
+	// Keys contains the keys, base64-encoded. The decryption key must only be
+	// returned if the request contained metadata.
+	Keys keyprovider.Output `json:"keys"`
+	// ExternalData is optional metadata, which must be a JSON object. It is
+	// stored alongside the encrypted data, and passed back to the command
+	// when decrypting that data.
+	ExternalData json.RawMessage `json:"external_data,omitempty"`
+}
@@ -0,0 +1,141 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package external contains a key provider that obtains its keys from an
+// external program, speaking a JSON protocol on the program's standard input
+// and output similar to that of the "external" data source.
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+type keyMeta struct {
+	ExternalData json.RawMessage `json:"external_data"`
+}
+
+func (m keyMeta) isPresent() bool {
+	return len(m.ExternalData) != 0 && string(m.ExternalData) != "null"
+}
+
+type keyProvider struct {
+	command []string
+}
+
+func (p keyProvider) Provide(rawMeta keyprovider.KeyMeta) (keyprovider.Output, keyprovider.KeyMeta, error) {
+	if rawMeta == nil {
+		return keyprovider.Output{}, nil, &keyprovider.ErrInvalidMetadata{
+			Message: "bug: no metadata struct provided",
+		}
+	}
+
+	inMeta, ok := rawMeta.(*keyMeta)
+	if !ok {
+		return keyprovider.Output{}, nil, &keyprovider.ErrInvalidMetadata{
+			Message: "bug: invalid metadata struct type",
+		}
+	}
+
+	req := request{}
+	if inMeta.isPresent() {
+		if !isJSONObject(inMeta.ExternalData) {
+			return keyprovider.Output{}, nil, &keyprovider.ErrInvalidMetadata{
+				Message: "the stored external_data is not a JSON object",
+			}
+		}
+		req.ExternalData = inMeta.ExternalData
+	}
+
+	resp, err := p.run(req)
+	if err != nil {
+		return keyprovider.Output{}, nil, err
+	}
+
+	if len(resp.Keys.EncryptionKey) == 0 {
+		return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+			Message: fmt.Sprintf("the program %q did not return an encryption key", p.command[0]),
+		}
+	}
+	out := keyprovider.Output{
+		EncryptionKey: resp.Keys.EncryptionKey,
+	}
+	if inMeta.isPresent() {
+		if len(resp.Keys.DecryptionKey) == 0 {
+			return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+				Message: fmt.Sprintf("the program %q did not return a decryption key", p.command[0]),
+			}
+		}
+		out.DecryptionKey = resp.Keys.DecryptionKey
+	}
+
+	// The metadata is always recorded, even if the program didn't return
+	// any, so that the program is asked for a decryption key when decrypting.
+	outMeta := &keyMeta{
+		ExternalData: json.RawMessage("{}"),
+	}
+	if len(resp.ExternalData) != 0 && string(resp.ExternalData) != "null" {
+		if !isJSONObject(resp.ExternalData) {
+			return keyprovider.Output{}, nil, &keyprovider.ErrKeyProviderFailure{
+				Message: fmt.Sprintf("the program %q returned external_data that is not a JSON object", p.command[0]),
+			}
+		}
+		outMeta.ExternalData = resp.ExternalData
+	}
+
+	return out, outMeta, nil
+}
+
+func (p keyProvider) run(req request) (*response, error) {
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, &keyprovider.ErrKeyProviderFailure{
+			Message: "failed to encode the request",
+			Cause:   err,
+		}
+	}
+
+	cmd := exec.Command(p.command[0], p.command[1:]...) //nolint:gosec // Running the configured program is the purpose of this key provider.
+	cmd.Stdin = bytes.NewReader(input)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			message := fmt.Sprintf("the program %q failed with exit code %d", p.command[0], exitErr.ExitCode())
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				message += ": " + msg
+			}
+			return nil, &keyprovider.ErrKeyProviderFailure{
+				Message: message,
+			}
+		}
+		return nil, &keyprovider.ErrKeyProviderFailure{
+			Message: fmt.Sprintf("failed to run the program %q", p.command[0]),
+			Cause:   err,
+		}
+	}
+
+	resp := &response{}
+	if err := json.Unmarshal(output, resp); err != nil {
+		return nil, &keyprovider.ErrKeyProviderFailure{
+			Message: fmt.Sprintf("the program %q produced invalid JSON output", p.command[0]),
+			Cause:   err,
+		}
+	}
+	return resp, nil
+}
+
+func isJSONObject(data json.RawMessage) bool {
+	var obj map[string]json.RawMessage
+	return json.Unmarshal(data, &obj) == nil && obj != nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider"
+)
+
+// helperArg makes the test binary act as an external key provider program
+// instead of running the tests. It must be followed by one of the modes
+// handled in runHelper.
+const helperArg = "-external-key-provider-helper"
+
+func TestMain(m *testing.M) {
+	if len(os.Args) > 2 && os.Args[1] == helperArg {
+		os.Exit(runHelper(os.Args[2]))
+	}
+	os.Exit(m.Run())
+}
+
+// runHelper implements a key provider program for testing. For simplicity the
+// key is stored in plain text in the metadata, which a real program would
+// never do.
+func runHelper(mode string) int {
+	var req struct {
+		ExternalData *struct {
+			Key []byte `json:"key"`
+		} `json:"external_data"`
+	}
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid request: %s", err)
+		return 1
+	}
+
+	switch mode {
+	case "fail":
+		fmt.Fprint(os.Stderr, "the HSM is not available")
+		return 3
+	case "invalid-output":
+		fmt.Print("this is not JSON")
+		return 0
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	resp := map[string]any{
+		"keys": map[string]any{
+			"encryption_key": key,
+		},
+	}
+	if mode != "no-metadata" {
+		resp["external_data"] = map[string]any{"key": key}
+	}
+	if req.ExternalData != nil {
+		if mode == "no-metadata" {
+			// The metadata is always passed back, even if the program
+			// didn't return any, so use a fixed key.
+			req.ExternalData.Key = make([]byte, 32)
+		}
+		resp["keys"].(map[string]any)["decryption_key"] = req.ExternalData.Key
+	}
+	if mode == "no-metadata" {
+		resp["keys"].(map[string]any)["encryption_key"] = make([]byte, 32)
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(resp); err != nil {
+		panic(err)
+	}
+	return 0
+}
+
+func helperCommand(mode string) []string {
+	return []string{os.Args[0], helperArg, mode}
+}
+
+func TestProvide_noMetadata(t *testing.T) {
+	p := &keyProvider{command: helperCommand("no-metadata")}
+
+	out, meta, err := p.Provide(new(keyMeta))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(out.DecryptionKey) != 0 {
+		t.Fatalf("unexpected decryption key")
+	}
+
+	// Even without metadata from the program, the metadata must be recorded
+	// so that the program is asked for the decryption key later.
+	if !meta.(*keyMeta).isPresent() {
+		t.Fatalf("metadata not recorded")
+	}
+	out2, _, err := p.Provide(meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(out2.DecryptionKey) != string(out.EncryptionKey) {
+		t.Fatalf("wrong decryption key")
+	}
+}
+
+func TestProvide_errors(t *testing.T) {
+	tests := map[string]struct {
+		command []string
+		meta    *keyMeta
+		wantErr string
+	}{
+		"exit code": {
+			command: helperCommand("fail"),
+			meta:    new(keyMeta),
+			wantErr: `failed with exit code 3: the HSM is not available`,
+		},
+		"invalid output": {
+			command: helperCommand("invalid-output"),
+			meta:    new(keyMeta),
+			wantErr: `produced invalid JSON output`,
+		},
+		"missing program": {
+			command: []string{"this-program-does-not-exist"},
+			meta:    new(keyMeta),
+			wantErr: `failed to run the program "this-program-does-not-exist"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &keyProvider{command: test.command}
+			_, _, err := p.Provide(test.meta)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			var typedErr *keyprovider.ErrKeyProviderFailure
+			if !errors.As(err, &typedErr) {
+				t.Fatalf("wrong error type %T: %s", err, err)
+			}
+			if !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("wrong error\ngot:  %s\nwant: %s", err, test.wantErr)
+			}
+		})
+	}
+}
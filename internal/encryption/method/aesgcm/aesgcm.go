@@ -55,6 +55,16 @@ func (a aesgcm) Encrypt(data []byte) ([]byte, error) {
 
 // Decrypt decrypts an AES-GCM-encrypted data set. If the data set fails decryption, it returns an error.
 func (a aesgcm) Decrypt(data []byte) ([]byte, error) {
+	return a.decrypt(data, false)
+}
+
+// DecryptInPlace decrypts an AES-GCM-encrypted data set like Decrypt, but writes the result over the encrypted data
+// instead of allocating a new buffer for it.
+func (a aesgcm) DecryptInPlace(data []byte) ([]byte, error) {
+	return a.decrypt(data, true)
+}
+
+func (a aesgcm) decrypt(data []byte, inPlace bool) ([]byte, error) {
 	if len(a.decryptionKey) == 0 {
 		return nil, &method.ErrDecryptionKeyUnavailable{}
 	}
@@ -86,7 +96,13 @@ func (a aesgcm) Decrypt(data []byte) ([]byte, error) {
 			nonce := data[:gcm.NonceSize()]
 			data = data[gcm.NonceSize():]
 
-			decrypted, err := gcm.Open(nil, nonce, data, a.aad)
+			var dst []byte
+			if inPlace {
+				// The output is always shorter than the ciphertext, because it
+				// doesn't include the authentication tag.
+				dst = data[:0]
+			}
+			decrypted, err := gcm.Open(dst, nonce, data, a.aad)
 			if err != nil {
 				return nil, &method.ErrDecryptionFailed{Cause: err}
 			}
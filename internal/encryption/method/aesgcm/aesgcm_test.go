@@ -6,6 +6,7 @@
 package aesgcm_test
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
@@ -96,3 +97,31 @@ func TestDecryptCorruptData(t *testing.T) {
 		t.Fatalf("Incorrect error type returned: %T (%v)", err, err)
 	}
 }
+
+func TestDecryptInPlace(t *testing.T) {
+	m, err := config.Build()
+	if err != nil {
+		t.Fatalf("unexpected error (%v)", err)
+	}
+
+	plain := []byte("Hello world!")
+	encrypted, err := m.Encrypt(plain)
+	if err != nil {
+		t.Fatalf("unexpected error (%v)", err)
+	}
+
+	inPlace, ok := m.(method.InPlaceDecrypter)
+	if !ok {
+		t.Fatalf("%T does not implement method.InPlaceDecrypter", m)
+	}
+	decrypted, err := inPlace.DecryptInPlace(encrypted)
+	if err != nil {
+		t.Fatalf("unexpected error (%v)", err)
+	}
+	if !bytes.Equal(decrypted, plain) {
+		t.Fatalf("incorrect decrypted data: %q", decrypted)
+	}
+	if &decrypted[0] != &encrypted[len(encrypted)-len(decrypted)-16] {
+		t.Fatalf("the data was not decrypted in place")
+	}
+}
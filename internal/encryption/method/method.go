@@ -17,3 +17,11 @@ type Method interface {
 	// interface.
 	Decrypt(data []byte) ([]byte, error)
 }
+
+// InPlaceDecrypter is an optional interface for methods that can decrypt data without allocating a separate buffer for
+// the result. This avoids holding two copies of the data in memory when decrypting large state files.
+type InPlaceDecrypter interface {
+	// DecryptInPlace decrypts the specified data in the same way as Method.Decrypt, but may reuse the memory of the
+	// data for the result. The caller must not use the data after calling this function, even if it returns an error.
+	DecryptInPlace(data []byte) ([]byte, error)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/encryption/config"
+	"github.com/opentofu/opentofu/internal/encryption/keyprovider/static"
+	"github.com/opentofu/opentofu/internal/encryption/method/aesgcm"
+	"github.com/opentofu/opentofu/internal/encryption/registry/lockingencryptionregistry"
+)
+
+func TestDecryptState_fallbackAfterInPlace(t *testing.T) {
+	// The primary method decrypts in place and fails because it has the wrong
+	// key, so the fallback method must get an intact copy of the data.
+	oldConfig := `
+key_provider "static" "old" {
+	key = "6f6f706830656f67686f6834616872756f3751756165686565796f6f72653169"
+}
+method "aes_gcm" "old" {
+	keys = key_provider.static.old
+}
+state {
+	method = method.aes_gcm.old
+}
+`
+	newConfig := `
+key_provider "static" "new" {
+	key = "7573686565317468656f6f3269656d6f686c61686e6761683561697761697965"
+}
+key_provider "static" "old" {
+	key = "6f6f706830656f67686f6834616872756f3751756165686565796f6f72653169"
+}
+method "aes_gcm" "new" {
+	keys = key_provider.static.new
+}
+method "aes_gcm" "old" {
+	keys = key_provider.static.old
+}
+state {
+	method = method.aes_gcm.new
+	fallback {
+		method = method.aes_gcm.old
+	}
+}
+`
+	plain := []byte(`{"terraform_version":"1.9.0","serial":1,"lineage":"magic"}`)
+	encrypted, err := newTestStateEncryption(t, oldConfig).EncryptState(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := newTestStateEncryption(t, newConfig).DecryptState(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, plain) {
+		t.Errorf("wrong decrypted state\ngot:  %s\nwant: %s", decrypted, plain)
+	}
+}
+
+// BenchmarkDecryptState measures the memory allocated to decrypt a large
+// state file, which should be little more than the size of the plaintext.
+func BenchmarkDecryptState(b *testing.B) {
+	sfe := newTestStateEncryption(b, ConfigB)
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"version":4,"terraform_version":"1.9.0","serial":1,"lineage":"magic","resources":[`)
+	for i := 0; buf.Len() < 16<<20; i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, `{"mode":"managed","type":"test_object","name":"r%d","instances":[{"attributes":{"id":"%d"}}]}`, i, i)
+	}
+	buf.WriteString(`]}`)
+	plain := buf.Bytes()
+
+	encrypted, err := sfe.EncryptState(plain)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(len(plain)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		decrypted, err := sfe.DecryptState(encrypted)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(decrypted) != len(plain) {
+			b.Fatalf("wrong decrypted length %d; want %d", len(decrypted), len(plain))
+		}
+	}
+}
+
+func newTestStateEncryption(t testing.TB, rawConfig string) encryption.StateEncryption {
+	t.Helper()
+
+	reg := lockingencryptionregistry.New()
+	if err := reg.RegisterKeyProvider(static.New()); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterMethod(aesgcm.New()); err != nil {
+		t.Fatal(err)
+	}
+	cfg, diags := config.LoadConfigFromString("Test Source", rawConfig)
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	enc, diags := encryption.New(reg, cfg, configs.NewStaticEvaluator(nil, configs.RootModuleCallForTesting()))
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	return enc.State()
+}
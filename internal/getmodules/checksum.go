@@ -0,0 +1,139 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package getmodules
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DirChecksumPrefix is the prefix of the checksums returned by HashDir, and
+// therefore of the checksums accepted by SplitGitChecksum.
+const DirChecksumPrefix = "sha256:"
+
+// SplitGitChecksum detects whether the given normalized package address is a
+// git package address with a "checksum" argument, and if so returns the
+// package address without that argument along with the checksum.
+//
+// go-getter only supports checksums of single files, and so would reject
+// this argument for a git repository. We instead treat the checksum of a git
+// package as the checksum of the installed module directory, as computed by
+// HashDir, and so the caller must verify it after installation.
+//
+// If the address has no checksum argument then it's returned verbatim along
+// with an empty checksum. An error is returned if the checksum isn't a
+// sha256 checksum in the format HashDir returns.
+func SplitGitChecksum(packageAddr string) (string, string, error) {
+	if !strings.HasPrefix(packageAddr, "git::") {
+		return packageAddr, "", nil
+	}
+	base, query, ok := strings.Cut(packageAddr, "?")
+	if !ok {
+		return packageAddr, "", nil
+	}
+
+	// We remove only the checksum argument, preserving the rest of the
+	// query string exactly as written.
+	var checksum string
+	var args []string
+	for _, arg := range strings.Split(query, "&") {
+		if v, ok := strings.CutPrefix(arg, "checksum="); ok {
+			checksum = v
+			continue
+		}
+		args = append(args, arg)
+	}
+	if checksum == "" {
+		return packageAddr, "", nil
+	}
+
+	digest, ok := strings.CutPrefix(checksum, DirChecksumPrefix)
+	if !ok {
+		return "", "", fmt.Errorf("unsupported checksum %q: the checksum of a git repository must be a sha256 checksum starting with %q", checksum, DirChecksumPrefix)
+	}
+	if raw, err := hex.DecodeString(digest); err != nil || len(raw) != sha256.Size {
+		return "", "", fmt.Errorf("invalid checksum %q: must be %q followed by %d hexadecimal digits", checksum, DirChecksumPrefix, sha256.Size*2)
+	}
+
+	if len(args) == 0 {
+		return base, strings.ToLower(checksum), nil
+	}
+	return base + "?" + strings.Join(args, "&"), strings.ToLower(checksum), nil
+}
+
+// HashDir computes a checksum of the files in the given directory and its
+// subdirectories, for verifying the contents of an installed module.
+//
+// The checksum covers the path and content of each regular file, and the path
+// and target of each symbolic link, and so it doesn't depend on file
+// modification times or permissions. Any ".git" directory is excluded,
+// because its content depends on how the repository was cloned.
+func HashDir(dir string) (string, error) {
+	type entry struct {
+		path string
+		hash string
+	}
+	var entries []entry
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		h := sha256.New()
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(h, "symlink:%s", filepath.ToSlash(target))
+		case d.Type().IsRegular():
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if _, err := io.Copy(h, f); err != nil {
+				return err
+			}
+		default:
+			// Other file types can't be part of a git repository.
+			return nil
+		}
+		entries = append(entries, entry{path: rel, hash: hex.EncodeToString(h.Sum(nil))})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.path, b.path)
+	})
+	sum := sha256.New()
+	for _, e := range entries {
+		fmt.Fprintf(sum, "%s  %s\n", e.hash, e.path)
+	}
+	return DirChecksumPrefix + hex.EncodeToString(sum.Sum(nil)), nil
+}
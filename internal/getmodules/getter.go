@@ -14,6 +14,7 @@ import (
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	getter "github.com/hashicorp/go-getter"
 	"github.com/opentofu/opentofu/internal/copy"
+	"github.com/opentofu/opentofu/internal/httpclient"
 )
 
 // We configure our own go-getter detector and getter sets here, because
@@ -75,22 +76,29 @@ var goGetterDecompressors = map[string]getter.Decompressor{
 	"txz":    new(getter.TarXzDecompressor),
 }
 
-var goGetterGetters = map[string]getter.Getter{
-	"file":  new(getter.FileGetter),
-	"gcs":   new(getter.GCSGetter),
-	"git":   new(getter.GitGetter),
-	"hg":    new(getter.HgGetter),
-	"s3":    new(getter.S3Getter),
-	"http":  getterHTTPGetter,
-	"https": getterHTTPGetter,
-}
-
-var getterHTTPClient = cleanhttp.DefaultClient()
+// goGetterGetters returns the getters to use for a package download.
+//
+// The HTTP getter is created on each call, rather than once, so that it
+// trusts any additional CA certificates that were loaded at startup by
+// httpclient.LoadCACertFile.
+func goGetterGetters() map[string]getter.Getter {
+	httpClient := cleanhttp.DefaultClient()
+	httpClient.Transport = httpclient.ConfigureTransport(httpClient.Transport)
+	httpGetter := &getter.HttpGetter{
+		Client:             httpClient,
+		Netrc:              true,
+		XTerraformGetLimit: 10,
+	}
 
-var getterHTTPGetter = &getter.HttpGetter{
-	Client:             getterHTTPClient,
-	Netrc:              true,
-	XTerraformGetLimit: 10,
+	return map[string]getter.Getter{
+		"file":  new(getter.FileGetter),
+		"gcs":   new(getter.GCSGetter),
+		"git":   new(getter.GitGetter),
+		"hg":    new(getter.HgGetter),
+		"s3":    new(getter.S3Getter),
+		"http":  httpGetter,
+		"https": httpGetter,
+	}
 }
 
 // A reusingGetter is a helper for the module installer that remembers
@@ -150,7 +158,7 @@ func (g reusingGetter) getWithGoGetter(ctx context.Context, instPath, packageAdd
 
 			Detectors:     goGetterNoDetectors, // our caller should've already done detection
 			Decompressors: goGetterDecompressors,
-			Getters:       goGetterGetters,
+			Getters:       goGetterGetters(),
 			Ctx:           ctx,
 		}
 		err = client.Get()
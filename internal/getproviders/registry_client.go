@@ -99,23 +99,88 @@ func newRegistryClient(baseURL *url.URL, creds svcauth.HostCredentials) *registr
 // ErrUnauthorized if the registry responds with 401 or 403 status codes, or
 // ErrQueryFailed for any other protocol or operational problem.
 func (c *registryClient) ProviderVersions(ctx context.Context, addr addrs.Provider) (map[string][]string, []string, error) {
+	body, err := c.providerVersions(ctx, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// We ignore the platforms portion of the response body here, because the
+	// installer verifies the platform compatibility after pulling a provider
+	// versions' metadata.
+	if len(body.Versions) == 0 {
+		return nil, body.Warnings, nil
+	}
+
+	ret := make(map[string][]string, len(body.Versions))
+	for _, v := range body.Versions {
+		ret[v.Version] = v.Protocols
+	}
+
+	return ret, body.Warnings, nil
+}
+
+// ProviderPlatforms returns the platforms that the registry advertises
+// packages for in the given version of the given provider, in the order
+// the registry returned them.
+//
+// The error cases are the same as for ProviderVersions, with the addition of
+// ErrQueryFailed if the registry doesn't list the given version at all.
+func (c *registryClient) ProviderPlatforms(ctx context.Context, addr addrs.Provider, version Version) ([]Platform, error) {
+	body, err := c.providerVersions(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range body.Versions {
+		parsed, err := ParseVersion(v.Version)
+		if err != nil || !parsed.Same(version) {
+			continue
+		}
+		ret := make([]Platform, 0, len(v.Platforms))
+		for _, p := range v.Platforms {
+			ret = append(ret, Platform{OS: p.OS, Arch: p.Arch})
+		}
+		return ret, nil
+	}
+
+	return nil, ErrQueryFailed{
+		Provider: addr,
+		Wrapped:  fmt.Errorf("registry does not list version %s", version),
+	}
+}
+
+// registryProviderVersionsResponse is the body of a successful response from
+// the registry's "versions" endpoint for a provider.
+type registryProviderVersionsResponse struct {
+	Versions []struct {
+		Version   string   `json:"version"`
+		Protocols []string `json:"protocols"`
+		Platforms []struct {
+			OS   string `json:"os"`
+			Arch string `json:"arch"`
+		} `json:"platforms"`
+	} `json:"versions"`
+	Warnings []string `json:"warnings"`
+}
+
+func (c *registryClient) providerVersions(ctx context.Context, addr addrs.Provider) (*registryProviderVersionsResponse, error) {
 	endpointPath, err := url.Parse(path.Join(addr.Namespace, addr.Type, "versions"))
 	if err != nil {
 		// Should never happen because we're constructing this from
 		// already-validated components.
-		return nil, nil, err
+		return nil, err
 	}
 	endpointURL := c.baseURL.ResolveReference(endpointPath)
 	req, err := retryablehttp.NewRequest("GET", endpointURL.String(), nil)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	req = req.WithContext(ctx)
 	c.addHeadersToRequest(req.Request)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, nil, c.errQueryFailed(addr, err)
+		return nil, c.errQueryFailed(addr, err)
 	}
 	defer resp.Body.Close()
 
@@ -123,42 +188,22 @@ func (c *registryClient) ProviderVersions(ctx context.Context, addr addrs.Provid
 	case http.StatusOK:
 		// Great!
 	case http.StatusNotFound:
-		return nil, nil, ErrRegistryProviderNotKnown{
+		return nil, ErrRegistryProviderNotKnown{
 			Provider: addr,
 		}
 	case http.StatusUnauthorized, http.StatusForbidden:
-		return nil, nil, c.errUnauthorized(addr.Hostname)
+		return nil, c.errUnauthorized(addr.Hostname)
 	default:
-		return nil, nil, c.errQueryFailed(addr, errors.New(resp.Status))
-	}
-
-	// We ignore the platforms portion of the response body, because the
-	// installer verifies the platform compatibility after pulling a provider
-	// versions' metadata.
-	type ResponseBody struct {
-		Versions []struct {
-			Version   string   `json:"version"`
-			Protocols []string `json:"protocols"`
-		} `json:"versions"`
-		Warnings []string `json:"warnings"`
+		return nil, c.errQueryFailed(addr, errors.New(resp.Status))
 	}
-	var body ResponseBody
 
+	var body registryProviderVersionsResponse
 	dec := json.NewDecoder(resp.Body)
 	if err := dec.Decode(&body); err != nil {
-		return nil, nil, c.errQueryFailed(addr, err)
-	}
-
-	if len(body.Versions) == 0 {
-		return nil, body.Warnings, nil
+		return nil, c.errQueryFailed(addr, err)
 	}
 
-	ret := make(map[string][]string, len(body.Versions))
-	for _, v := range body.Versions {
-		ret[v.Version] = v.Protocols
-	}
-
-	return ret, body.Warnings, nil
+	return &body, nil
 }
 
 // PackageMeta returns metadata about a distribution package for a provider.
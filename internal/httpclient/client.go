@@ -13,12 +13,13 @@ import (
 )
 
 // New returns the DefaultPooledClient from the cleanhttp
-// package that will also send a OpenTofu User-Agent string.
+// package that will also send a OpenTofu User-Agent string, and trust any
+// additional CA certificates loaded by LoadCACertFile.
 func New() *http.Client {
 	cli := cleanhttp.DefaultPooledClient()
 	cli.Transport = &userAgentRoundTripper{
 		userAgent: OpenTofuUserAgent(version.Version),
-		inner:     cli.Transport,
+		inner:     ConfigureTransport(cli.Transport),
 	}
 	return cli
 }
@@ -0,0 +1,76 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+)
+
+// CACertFileEnvVar is the environment variable that names a file of
+// PEM-encoded CA certificates to trust, in addition to the system's roots,
+// when connecting to registries and downloading modules and providers.
+const CACertFileEnvVar = "TF_CA_CERT_FILE"
+
+// rootCAs is the pool of root certificates used to verify TLS servers, or nil
+// to use the system's roots. It is set once at startup by LoadCACertFile.
+//
+//nolint:gochecknoglobals // This is a process-wide setting, like the user agent.
+var rootCAs *x509.CertPool
+
+// LoadCACertFile adds the PEM-encoded CA certificates in the file at the given
+// path to the roots that clients created by this package, and transports
+// passed to ConfigureTransport, trust for TLS connections. The system's roots
+// remain trusted too.
+//
+// This must be called before creating any clients that should trust the
+// additional certificates, and should not be called concurrently with
+// creating them.
+func LoadCACertFile(path string) error {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read CA certificates: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		// The system pool isn't available on all platforms, in which case
+		// we can only trust the given certificates.
+		log.Printf("[WARN] Failed to load the system's root CA certificates: %s", err)
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no PEM-encoded certificates found in %s", path)
+	}
+
+	log.Printf("[DEBUG] Trusting additional CA certificates from %s", path)
+	rootCAs = pool
+	return nil
+}
+
+// ConfigureTransport returns a transport that trusts any additional CA
+// certificates loaded by LoadCACertFile.
+//
+// If no additional certificates were loaded, or the given transport is not
+// an *http.Transport, it is returned unchanged. Otherwise the result is a
+// modified copy, so a shared transport is never changed in place.
+func ConfigureTransport(rt http.RoundTripper) http.RoundTripper {
+	t, ok := rt.(*http.Transport)
+	if rootCAs == nil || !ok {
+		return rt
+	}
+
+	t = t.Clone()
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{} //nolint:gosec // The defaults are fine; we only need to set the roots
+	}
+	t.TLSClientConfig.RootCAs = rootCAs
+	return t
+}
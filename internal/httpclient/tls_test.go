@@ -0,0 +1,106 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCACertFile(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	defer ts.Close()
+
+	defer func() { rootCAs = nil }()
+
+	// Without the server's CA, the request must fail verification.
+	if resp, err := New().Get(ts.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("request succeeded without trusting the server's CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadCACertFile(caFile); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := New().Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request failed after trusting the server's CA: %s", err)
+	}
+	resp.Body.Close()
+
+	// The system's roots are still trusted alongside the loaded one.
+	if rootCAs.Equal(mustNewPoolFromPEM(t, caPEM)) {
+		t.Error("the loaded CA replaced the system roots instead of adding to them")
+	}
+}
+
+func TestLoadCACertFile_invalid(t *testing.T) {
+	defer func() { rootCAs = nil }()
+
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, path := range map[string]string{
+		"missing": filepath.Join(dir, "missing.pem"),
+		"not PEM": notPEM,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := LoadCACertFile(path); err == nil {
+				t.Fatal("succeeded; want error")
+			}
+			if rootCAs != nil {
+				t.Fatal("roots were changed despite the error")
+			}
+		})
+	}
+}
+
+func TestConfigureTransport(t *testing.T) {
+	defer func() { rootCAs = nil }()
+
+	orig := &http.Transport{}
+	if got := ConfigureTransport(orig); got != orig {
+		t.Fatal("transport was replaced although no CA certificates were loaded")
+	}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	defer ts.Close()
+	rootCAs = mustNewPoolFromPEM(t, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+
+	got, ok := ConfigureTransport(orig).(*http.Transport)
+	if !ok || got == orig {
+		t.Fatal("expected a modified copy of the transport")
+	}
+	if got.TLSClientConfig == nil || got.TLSClientConfig.RootCAs != rootCAs {
+		t.Error("copy does not trust the loaded CA certificates")
+	}
+	if orig.TLSClientConfig != nil && orig.TLSClientConfig.RootCAs != nil {
+		t.Error("original transport was modified")
+	}
+}
+
+func mustNewPoolFromPEM(t *testing.T, caPEM []byte) *x509.CertPool {
+	t.Helper()
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		t.Fatal("invalid PEM")
+	}
+	return pool
+}
@@ -240,6 +240,10 @@ func (i *ModuleInstaller) moduleInstallWalker(ctx context.Context, manifest mods
 				// keep our existing record.
 				info, err := os.Stat(record.Dir)
 				if err == nil && info.IsDir() {
+					if cDiags := verifyModuleChecksum(req, record.Dir); cDiags.HasErrors() {
+						diags = diags.Extend(cDiags)
+						return nil, nil, diags
+					}
 					mod, mDiags := i.loader.Parser().LoadConfigDir(record.Dir, req.Call)
 					if mod == nil {
 						// nil indicates an unreadable module, which should never happen,
@@ -715,7 +719,20 @@ func (i *ModuleInstaller) installGoGetterModule(ctx context.Context, req *config
 		return nil, diags
 	}
 
-	err := fetcher.FetchPackage(ctx, instPath, packageAddr.String())
+	// A checksum of a git package is for OpenTofu to verify once the
+	// module is installed, so go-getter must not see it.
+	fetchAddr, _, err := getmodules.SplitGitChecksum(packageAddr.String())
+	if err != nil {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid module source checksum",
+			Detail:   fmt.Sprintf("Cannot install module %q (%s:%d) from %q: %s.", req.Name, req.CallRange.Filename, req.CallRange.Start.Line, packageAddr, err),
+			Subject:  req.CallRange.Ptr(),
+		})
+		return nil, diags
+	}
+
+	err = fetcher.FetchPackage(ctx, instPath, fetchAddr)
 	if err != nil {
 		// go-getter generates a poor error for an invalid relative path, so
 		// we'll detect that case and generate a better one.
@@ -763,6 +780,11 @@ func (i *ModuleInstaller) installGoGetterModule(ctx context.Context, req *config
 
 	log.Printf("[TRACE] ModuleInstaller: %s %q was downloaded to %s", key, addr, modDir)
 
+	if cDiags := verifyModuleChecksum(req, modDir); cDiags.HasErrors() {
+		diags = diags.Extend(cDiags)
+		return nil, diags
+	}
+
 	// Finally we are ready to try actually loading the module.
 	mod, mDiags := i.loader.Parser().LoadConfigDir(modDir, req.Call)
 	if mod == nil {
@@ -797,6 +819,48 @@ func (i *ModuleInstaller) installGoGetterModule(ctx context.Context, req *config
 	return mod, diags
 }
 
+// verifyModuleChecksum checks that the content of the given module directory
+// matches the checksum given in the module's source address, if any.
+//
+// Only git source addresses can include a checksum of the module directory,
+// so this does nothing for any other module.
+func verifyModuleChecksum(req *configs.ModuleRequest, modDir string) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	addr, ok := req.SourceAddr.(addrs.ModuleSourceRemote)
+	if !ok {
+		return diags
+	}
+	_, want, err := getmodules.SplitGitChecksum(addr.Package.String())
+	if err != nil || want == "" {
+		// An invalid checksum is reported when installing the module.
+		return diags
+	}
+
+	got, err := getmodules.HashDir(modDir)
+	if err != nil {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Failed to verify module checksum",
+			Detail:   fmt.Sprintf("Could not compute the checksum of module %q (%s:%d) in %s: %s.", req.Name, req.CallRange.Filename, req.CallRange.Start.Line, modDir, err),
+			Subject:  req.CallRange.Ptr(),
+		})
+		return diags
+	}
+	if got != want {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Module checksum mismatch",
+			Detail: fmt.Sprintf(
+				"The content of module %q (%s:%d) from %q doesn't match the checksum in its source address.\n\nExpected: %s\nActual:   %s\n\nThe module source code may have been changed since the checksum was recorded. If you trust the new content, update the checksum in the source address.",
+				req.Name, req.CallRange.Filename, req.CallRange.Start.Line, addr, want, got,
+			),
+			Subject: req.CallRange.Ptr(),
+		})
+	}
+	return diags
+}
+
 func (i *ModuleInstaller) packageInstallPath(modulePath addrs.Module) string {
 	return filepath.Join(i.modsDir, strings.Join(modulePath, "."))
 }
@@ -11,6 +11,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -25,6 +26,7 @@ import (
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/configs/configload"
 	"github.com/opentofu/opentofu/internal/copy"
+	"github.com/opentofu/opentofu/internal/getmodules"
 	"github.com/opentofu/opentofu/internal/registry"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 
@@ -431,6 +433,93 @@ func TestModuleInstaller_symlink(t *testing.T) {
 	assertResultDeepEqual(t, gotTraces, wantTraces)
 }
 
+func TestModuleInstaller_gitChecksum(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	// We create a git repository with the module in a subdirectory, and
+	// another file outside it which must not contribute to the checksum.
+	repoDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(repoDir, "child"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repoDir, "child", "main.tf"), []byte("variable \"v\" {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repoDir, "README"), []byte("not part of the module\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %s\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+	checksum, err := getmodules.HashDir(filepath.Join(repoDir, "child"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	install := func(t *testing.T, checksum string) (string, tfdiags.Diagnostics) {
+		t.Helper()
+		dir := t.TempDir()
+		config := fmt.Sprintf("module \"child\" {\n  source = %q\n}\n", "git::file://"+filepath.ToSlash(repoDir)+"//child?checksum="+checksum)
+		if err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(config), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		oldDir, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chdir(dir); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if err := os.Chdir(oldDir); err != nil {
+				t.Fatal(err)
+			}
+		})
+		return dir, installModulesForTest(t)
+	}
+
+	t.Run("match", func(t *testing.T) {
+		dir, diags := install(t, checksum)
+		assertNoDiagnostics(t, diags)
+
+		// A later installation verifies the module that's already installed.
+		if err := os.WriteFile(filepath.Join(dir, ".terraform", "modules", "child", "child", "main.tf"), []byte("variable \"w\" {}\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		diags = installModulesForTest(t)
+		assertDiagnosticSummary(t, diags, "Module checksum mismatch")
+	})
+	t.Run("mismatch", func(t *testing.T) {
+		_, diags := install(t, getmodules.DirChecksumPrefix+strings.Repeat("0", 64))
+		assertDiagnosticSummary(t, diags, "Module checksum mismatch")
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, diags := install(t, "md5:0123")
+		assertDiagnosticSummary(t, diags, "Invalid module source checksum")
+	})
+}
+
+// installModulesForTest installs the modules of the configuration in the
+// current working directory.
+func installModulesForTest(t *testing.T) tfdiags.Diagnostics {
+	t.Helper()
+	loader, close := configload.NewLoaderForTests(t)
+	defer close()
+	inst := NewModuleInstaller(filepath.Join(".terraform", "modules"), loader, nil)
+	_, diags := inst.InstallModules(context.Background(), ".", "tests", false, false, &testInstallHooks{}, configs.RootModuleCallForTesting())
+	return diags
+}
+
 func TestLoaderInstallModules_registry(t *testing.T) {
 	if os.Getenv("TF_ACC") == "" {
 		t.Skip("this test accesses registry.opentofu.org and github.com; set TF_ACC=1 to run it")
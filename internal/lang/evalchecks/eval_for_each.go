@@ -5,6 +5,8 @@
 package evalchecks
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/hashicorp/hcl/v2"
@@ -13,6 +15,7 @@ import (
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
 const (
@@ -45,11 +48,79 @@ func EvaluateForEachExpression(expr hcl.Expression, ctx ContextFunc) (map[string
 	return forEachVal.AsValueMap(), diags
 }
 
+// EvaluateHashedForEachExpression is like EvaluateForEachExpression, except
+// that a set may contain elements of any type and each element is keyed by
+// a stable hash of its value, as returned by HashedForEachKey, rather than by
+// the element itself. Maps are keyed by their own keys as usual.
+//
+// This is used for resources that opt in to hashed instance keys, so that the
+// instance keys depend only on the set elements and not on how the set was
+// constructed.
+func EvaluateHashedForEachExpression(expr hcl.Expression, ctx ContextFunc) (map[string]cty.Value, tfdiags.Diagnostics) {
+	const unknownsNotAllowed = false
+	forEachVal, diags := EvaluateHashedForEachExpressionValue(expr, ctx, unknownsNotAllowed)
+
+	if forEachVal.IsNull() || !forEachVal.IsKnown() || markSafeLengthInt(forEachVal) == 0 {
+		return map[string]cty.Value{}, diags
+	}
+
+	if !forEachVal.Type().IsSetType() {
+		return forEachVal.AsValueMap(), diags
+	}
+
+	ret := make(map[string]cty.Value, forEachVal.LengthInt())
+	it := forEachVal.ElementIterator()
+	for it.Next() {
+		_, elem := it.Element()
+		key, err := HashedForEachKey(elem)
+		if err != nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid for_each set argument",
+				Detail:   fmt.Sprintf(`The given "for_each" argument value is unsuitable: cannot compute an instance key for a set element: %s.`, err),
+				Subject:  expr.Range().Ptr(),
+			})
+			return map[string]cty.Value{}, diags
+		}
+		ret[key] = elem
+	}
+	return ret, diags
+}
+
+// EvaluateHashedForEachExpressionValue is like EvaluateForEachExpressionValue
+// except that a set may contain elements of any type, as for
+// EvaluateHashedForEachExpression.
+func EvaluateHashedForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowUnknown bool) (cty.Value, tfdiags.Diagnostics) {
+	const tupleNotAllowed = false
+	const anyElementType = true
+	return evaluateForEachExpressionValue(expr, ctx, allowUnknown, tupleNotAllowed, anyElementType)
+}
+
+// HashedForEachKey returns the instance key used for the given set element
+// by EvaluateHashedForEachExpression.
+//
+// The key is derived from the JSON serialization of the value, and so is the
+// same for equal values regardless of where they came from.
+func HashedForEachKey(val cty.Value) (string, error) {
+	val, _ = val.UnmarkDeep()
+	src, err := ctyjson.Marshal(val, val.Type())
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(src)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
 // EvaluateForEachExpressionValue is like EvaluateForEachExpression
 // except that it returns a cty.Value map or set which can be unknown.
 // The 'allowTuple' argument is used to support evaluating for_each from tuple
 // values, and is currently supported when using for_each in import blocks.
 func EvaluateForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowUnknown bool, allowTuple bool) (cty.Value, tfdiags.Diagnostics) {
+	const stringElementsOnly = false
+	return evaluateForEachExpressionValue(expr, ctx, allowUnknown, allowTuple, stringElementsOnly)
+}
+
+func evaluateForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowUnknown bool, allowTuple bool, anyElementType bool) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	nullMap := cty.NullVal(cty.Map(cty.DynamicPseudoType))
 
@@ -93,6 +164,9 @@ func EvaluateForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowU
 	} else {
 		isAllowedType = ty.IsMapType() || ty.IsSetType() || ty.IsObjectType()
 		allowedTypesMessage = "map, or set of strings"
+		if anyElementType {
+			allowedTypesMessage = "map, or set"
+		}
 	}
 
 	// Check if the type is allowed whether the value is marked or not
@@ -112,7 +186,7 @@ func EvaluateForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowU
 		return nullMap, diags
 	}
 
-	forEachVal, diags = performForEachValueChecks(expr, hclCtx, allowUnknown, forEachVal, allowedTypesMessage)
+	forEachVal, diags = performForEachValueChecks(expr, hclCtx, allowUnknown, anyElementType, forEachVal, allowedTypesMessage)
 	if diags.HasErrors() {
 		return forEachVal, diags
 	}
@@ -121,7 +195,7 @@ func EvaluateForEachExpressionValue(expr hcl.Expression, ctx ContextFunc, allowU
 }
 
 // performForEachValueChecks ensures the for_each argument is valid
-func performForEachValueChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnknown bool, forEachVal cty.Value, allowedTypesMessage string) (cty.Value, tfdiags.Diagnostics) {
+func performForEachValueChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnknown bool, anyElementType bool, forEachVal cty.Value, allowedTypesMessage string) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	nullMap := cty.NullVal(cty.Map(cty.DynamicPseudoType))
 	ty := forEachVal.Type()
@@ -167,7 +241,7 @@ func performForEachValueChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, all
 	}
 
 	if ty.IsSetType() {
-		setVal, setTypeDiags := performSetTypeChecks(expr, hclCtx, allowUnknown, forEachVal)
+		setVal, setTypeDiags := performSetTypeChecks(expr, hclCtx, allowUnknown, anyElementType, forEachVal)
 		diags = diags.Append(setTypeDiags)
 		if diags.HasErrors() {
 			return setVal, diags
@@ -178,7 +252,7 @@ func performForEachValueChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, all
 }
 
 // performSetTypeChecks does checks when we have a Set type, as sets have some gotchas
-func performSetTypeChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnknown bool, forEachVal cty.Value) (cty.Value, tfdiags.Diagnostics) {
+func performSetTypeChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnknown bool, anyElementType bool, forEachVal cty.Value) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	ty := forEachVal.Type()
 
@@ -199,7 +273,7 @@ func performSetTypeChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnk
 		return cty.UnknownVal(ty), diags
 	}
 
-	if ty.ElementType() != cty.String {
+	if ty.ElementType() != cty.String && !anyElementType {
 		diags = diags.Append(&hcl.Diagnostic{
 			Severity:    hcl.DiagError,
 			Summary:     "Invalid for_each set argument",
@@ -211,8 +285,8 @@ func performSetTypeChecks(expr hcl.Expression, hclCtx *hcl.EvalContext, allowUnk
 		return cty.NullVal(ty), diags
 	}
 
-	// A set of strings may contain null, which makes it impossible to
-	// convert to a map, so we must return an error
+	// A set may contain null, which makes it impossible to convert to a map,
+	// so we must return an error
 	it := forEachVal.ElementIterator()
 	for it.Next() {
 		item, _ := it.Element()
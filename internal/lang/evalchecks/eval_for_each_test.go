@@ -396,3 +396,90 @@ func TestEvaluateForEachExpressionValueTuple(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateHashedForEachExpression(t *testing.T) {
+	objA := cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a"), "size": cty.NumberIntVal(1)})
+	objB := cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("b"), "size": cty.NumberIntVal(2)})
+
+	keyA, err := HashedForEachKey(objA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyB, err := HashedForEachKey(objB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyStr, err := HashedForEachKey(cty.StringVal("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyA == keyB {
+		t.Fatalf("different values have the same key %q", keyA)
+	}
+
+	tests := map[string]struct {
+		Expr       hcl.Expression
+		ForEachMap map[string]cty.Value
+	}{
+		"set of objects": {
+			hcltest.MockExprLiteral(cty.SetVal([]cty.Value{objA, objB})),
+			map[string]cty.Value{
+				keyA: objA,
+				keyB: objB,
+			},
+		},
+		"set of objects in a different order": {
+			hcltest.MockExprLiteral(cty.SetVal([]cty.Value{objB, objA})),
+			map[string]cty.Value{
+				keyA: objA,
+				keyB: objB,
+			},
+		},
+		"set of strings": {
+			hcltest.MockExprLiteral(cty.SetVal([]cty.Value{cty.StringVal("a")})),
+			map[string]cty.Value{
+				keyStr: cty.StringVal("a"),
+			},
+		},
+		"map keeps its keys": {
+			hcltest.MockExprLiteral(cty.MapVal(map[string]cty.Value{
+				"a": objA,
+			})),
+			map[string]cty.Value{
+				"a": objA,
+			},
+		},
+		"empty set": {
+			hcltest.MockExprLiteral(cty.SetValEmpty(cty.String)),
+			map[string]cty.Value{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			forEachMap, diags := EvaluateHashedForEachExpression(test.Expr, mockRefsFunc())
+
+			if len(diags) != 0 {
+				t.Errorf("unexpected diagnostics %s", spew.Sdump(diags))
+			}
+
+			if !reflect.DeepEqual(forEachMap, test.ForEachMap) {
+				t.Errorf(
+					"wrong map value\ngot:  %swant: %s",
+					spew.Sdump(forEachMap), spew.Sdump(test.ForEachMap),
+				)
+			}
+		})
+	}
+}
+
+func TestEvaluateHashedForEachExpression_nullElement(t *testing.T) {
+	expr := hcltest.MockExprLiteral(cty.SetVal([]cty.Value{cty.NullVal(cty.Number), cty.NumberIntVal(1)}))
+	_, diags := EvaluateHashedForEachExpression(expr, mockRefsFunc())
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+	if got, want := diags[0].Description().Detail, "sets must not contain null values"; !strings.Contains(got, want) {
+		t.Errorf("wrong error detail %q; want to contain %q", got, want)
+	}
+}
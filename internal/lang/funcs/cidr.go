@@ -220,7 +220,7 @@ var CidrContainsFunc = function.New(&function.Spec{
 		// The first argument must be a CIDR prefix.
 		_, containing, err := ipaddr.ParseCIDR(prefix)
 		if err != nil {
-			return cty.UnknownVal(cty.Bool), err
+			return cty.UnknownVal(cty.Bool), function.NewArgError(0, err)
 		}
 
 		// The second argument can be either an IP address or a CIDR prefix.
@@ -235,7 +235,7 @@ var CidrContainsFunc = function.New(&function.Spec{
 
 			// If that also fails, we'll return an error.
 			if err != nil {
-				return cty.UnknownVal(cty.Bool), fmt.Errorf("invalid IP address or prefix: %s", addr)
+				return cty.UnknownVal(cty.Bool), function.NewArgErrorf(1, "invalid IP address or prefix: %s", addr)
 			}
 
 			// Otherwise, we will want to know the start and the end IP of the
@@ -251,7 +251,7 @@ var CidrContainsFunc = function.New(&function.Spec{
 		// distinguish between a "legitimate" false result and an erroneous
 		// check.
 		if (startIP.To4() == nil) != (containing.IP.To4() == nil) {
-			return cty.UnknownVal(cty.Bool), fmt.Errorf("address family mismatch: %s vs. %s", prefix, addr)
+			return cty.UnknownVal(cty.Bool), function.NewArgErrorf(1, "address family mismatch: %s vs. %s", prefix, addr)
 		}
 
 		// If the second argument was an IP address, we will check whether it
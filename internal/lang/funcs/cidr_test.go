@@ -6,10 +6,12 @@
 package funcs
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 )
 
 func TestCidrHost(t *testing.T) {
@@ -436,6 +438,20 @@ func TestCidrContains(t *testing.T) {
 			cty.False,
 			noError,
 		},
+		{
+			// IPv4, same prefix.
+			cty.StringVal("192.168.0.0/20"),
+			cty.StringVal("192.168.0.0/20"),
+			cty.True,
+			noError,
+		},
+		{
+			// IPv4, not contained (CIDR with the same start but a larger range).
+			cty.StringVal("192.168.0.0/20"),
+			cty.StringVal("192.168.0.0/16"),
+			cty.False,
+			noError,
+		},
 		{
 			// IPv6, contained.
 			cty.StringVal("fe80::/48"),
@@ -443,6 +459,20 @@ func TestCidrContains(t *testing.T) {
 			cty.True,
 			noError,
 		},
+		{
+			// IPv6, contained (CIDR).
+			cty.StringVal("fe80::/48"),
+			cty.StringVal("fe80:0:0:ff00::/56"),
+			cty.True,
+			noError,
+		},
+		{
+			// IPv6, not contained (CIDR).
+			cty.StringVal("fe80::/48"),
+			cty.StringVal("fe80::/32"),
+			cty.False,
+			noError,
+		},
 		{
 			// IPv6, not contained.
 			cty.StringVal("fe80::/48"),
@@ -456,7 +486,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("fe80::1"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "address family mismatch: 192.168.2.0/20 vs. fe80::1"
+				return isArgError(err, 1, "address family mismatch: 192.168.2.0/20 vs. fe80::1")
 			},
 		},
 		{
@@ -465,7 +495,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("fe80::/24"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "address family mismatch: 192.168.2.0/20 vs. fe80::/24"
+				return isArgError(err, 1, "address family mismatch: 192.168.2.0/20 vs. fe80::/24")
 			},
 		},
 		{
@@ -474,7 +504,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("192.168.2.1"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "address family mismatch: fe80::/48 vs. 192.168.2.1"
+				return isArgError(err, 1, "address family mismatch: fe80::/48 vs. 192.168.2.1")
 			},
 		},
 		{
@@ -483,7 +513,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("192.168.2.0/20"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "address family mismatch: fe80::/48 vs. 192.168.2.0/20"
+				return isArgError(err, 1, "address family mismatch: fe80::/48 vs. 192.168.2.0/20")
 			},
 		},
 		{
@@ -492,7 +522,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("192.168.2.1"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "invalid CIDR address: not-a-cidr"
+				return isArgError(err, 0, "invalid CIDR address: not-a-cidr")
 			},
 		},
 		{
@@ -501,7 +531,7 @@ func TestCidrContains(t *testing.T) {
 			cty.StringVal("not-an-address"),
 			cty.NilVal,
 			func(err error) bool {
-				return err != nil && err.Error() == "invalid IP address or prefix: not-an-address"
+				return isArgError(err, 1, "invalid IP address or prefix: not-an-address")
 			},
 		},
 	}
@@ -520,3 +550,10 @@ func TestCidrContains(t *testing.T) {
 		})
 	}
 }
+
+// isArgError returns true if err is an error for the argument at the given
+// index, with the given message.
+func isArgError(err error, index int, msg string) bool {
+	var argErr function.ArgError
+	return errors.As(err, &argErr) && argErr.Index == index && err.Error() == msg
+}
@@ -157,6 +157,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`csvdecode` decodes a string containing CSV-formatted data and produces a list of maps representing that data.",
 		ParamDescription: []string{""},
 	},
+	"dirhash": {
+		Description:      "`dirhash` computes a hash of the names and contents of the files in a directory that match a given pattern. The result is the same regardless of the platform OpenTofu is running on.",
+		ParamDescription: []string{"", ""},
+	},
 	"dirname": {
 		Description:      "`dirname` takes a string containing a filesystem path and removes the last portion from it.",
 		ParamDescription: []string{""},
@@ -361,6 +365,10 @@ var DescriptionList = map[string]descriptionEntry{
 		Description:      "`sensitive` takes any value and returns a copy of it marked so that OpenTofu will treat it as sensitive, with the same meaning and behavior as for [sensitive input variables](/language/values/variables#suppressing-values-in-cli-output).",
 		ParamDescription: []string{""},
 	},
+	"sensitivevalues": {
+		Description:      "`sensitivevalues` takes a map or object and returns a copy of it where each element is marked as sensitive, while its keys remain visible.",
+		ParamDescription: []string{""},
+	},
 	"setintersection": {
 		Description:      "The `setintersection` function takes multiple sets and produces a single set containing only the elements that all of the given sets have in common. In other words, it computes the [intersection](https://en.wikipedia.org/wiki/Intersection_\\(set_theory\\)) of the sets.",
 		ParamDescription: []string{"", ""},
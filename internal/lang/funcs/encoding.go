@@ -181,7 +181,7 @@ var Base64GzipFunc = function.New(&function.Spec{
 	},
 })
 
-// Base64GunzipFunc constructs a function that Bae64 decodes a string and decompresses the result with gunzip.
+// Base64GunzipFunc constructs a function that Base64 decodes a string and decompresses the result with gunzip.
 var Base64GunzipFunc = function.New(&function.Spec{
 	Params: []function.Parameter{
 		{
@@ -194,6 +194,11 @@ var Base64GunzipFunc = function.New(&function.Spec{
 	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
 		str, strMarks := args[0].Unmark()
 		s := str.AsString()
+		if s == "" {
+			// There is no gzip stream at all in an empty string, so there's
+			// nothing to decompress either.
+			return cty.StringVal(""), nil
+		}
 		sDec, err := base64.StdEncoding.DecodeString(s)
 		if err != nil {
 			return cty.UnknownVal(cty.String), fmt.Errorf("failed to decode base64 data %s", redactIfSensitive(s, strMarks))
@@ -207,6 +212,9 @@ var Base64GunzipFunc = function.New(&function.Spec{
 		if err != nil {
 			return cty.UnknownVal(cty.String), fmt.Errorf("failed to read gunzip raw data: %w", err)
 		}
+		if !utf8.Valid(gunzip) {
+			return cty.UnknownVal(cty.String), fmt.Errorf("the result of decompressing the provided string is not valid UTF-8")
+		}
 
 		return cty.StringVal(string(gunzip)), nil
 	},
@@ -173,6 +173,21 @@ func TestBase64Gunzip(t *testing.T) {
 			cty.StringVal("test"),
 			false,
 		},
+		{
+			cty.StringVal("H4sIAAAAAAAC/8s4vDInJ1+h/PC2opwUALlfHRUNAAAA"),
+			cty.StringVal("héllo wörld"),
+			false,
+		},
+		{
+			cty.StringVal("H4sIAAAAAAAC/wMAAAAAAAAAAAA="),
+			cty.StringVal(""),
+			false,
+		},
+		{
+			cty.StringVal(""),
+			cty.StringVal(""),
+			false,
+		},
 	}
 
 	for _, test := range tests {
@@ -195,6 +210,44 @@ func TestBase64Gunzip(t *testing.T) {
 	}
 }
 
+func TestBase64Gunzip_error(t *testing.T) {
+	tests := map[string]struct {
+		String  cty.Value
+		WantErr string
+	}{
+		"invalid base64": {
+			cty.StringVal("dfg"),
+			`failed to decode base64 data "dfg"`,
+		},
+		"not gzip": {
+			cty.StringVal("bm90IGEgZ3ppcCBzdHJlYW0="),
+			"failed to gunzip bytestream: gzip: invalid header",
+		},
+		"truncated gzip": {
+			cty.StringVal("H4sIAAAAAAAC/8tIzcnJVyjPL8pJAQCFEQ=="),
+			"failed to read gunzip raw data: unexpected EOF",
+		},
+		"invalid utf-8": {
+			cty.StringVal("H4sIAAAAAAAC//v/DwCWMPiIAgAAAA=="),
+			"the result of decompressing the provided string is not valid UTF-8",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Base64Gunzip(test.String)
+
+			if err == nil {
+				t.Fatal("succeeded; want error")
+			}
+
+			if err.Error() != test.WantErr {
+				t.Errorf("wrong error result\ngot:  %#v\nwant: %#v", err.Error(), test.WantErr)
+			}
+		})
+	}
+}
+
 func TestURLEncode(t *testing.T) {
 	tests := []struct {
 		String cty.Value
@@ -6,12 +6,14 @@
 package funcs
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -353,6 +355,192 @@ func MakeFileSetFunc(baseDir string) function.Function {
 	})
 }
 
+// MakeDirHashFunc constructs a function that takes a directory path and a
+// glob pattern and returns a hash of the names and contents of the files in
+// that directory that match the pattern.
+//
+// The result uses the same "h1:" scheme as Go module checksums: the SHA256
+// hash of a summary with one line per file, sorted by the file's path
+// relative to the given directory, using forward slashes on all platforms.
+// Symbolic links are followed, but the directory and everything it links to
+// must be inside the base directory.
+func MakeDirHashFunc(baseDir string) function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{
+			{
+				Name:        "path",
+				Type:        cty.String,
+				AllowMarked: true,
+			},
+			{
+				Name:        "pattern",
+				Type:        cty.String,
+				AllowMarked: true,
+			},
+		},
+		Type:         function.StaticReturnType(cty.String),
+		RefineResult: refineNotNull,
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			pathArg, pathMarks := args[0].Unmark()
+			path := pathArg.AsString()
+			patternArg, patternMarks := args[1].Unmark()
+			pattern := patternArg.AsString()
+
+			marks := []cty.ValueMarks{pathMarks, patternMarks}
+
+			if !doublestar.ValidatePattern(pattern) {
+				return cty.UnknownVal(cty.String), fmt.Errorf("invalid glob pattern %s", redactIfSensitive(pattern, marks...))
+			}
+
+			root, err := filepath.Abs(baseDir)
+			if err != nil {
+				return cty.UnknownVal(cty.String), fmt.Errorf("failed to resolve the module directory: %w", err)
+			}
+			root, err = filepath.EvalSymlinks(root)
+			if err != nil {
+				return cty.UnknownVal(cty.String), fmt.Errorf("failed to resolve the module directory: %w", err)
+			}
+
+			dir := path
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(baseDir, dir)
+			}
+			dir, err = filepath.Abs(dir)
+			if err != nil {
+				return cty.UnknownVal(cty.String), fmt.Errorf("failed to resolve %s: %w", redactIfSensitive(path, marks...), err)
+			}
+			dir, err = filepath.EvalSymlinks(dir)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return cty.UnknownVal(cty.String), fmt.Errorf("no directory exists at %s; this function works only with files that are distributed as part of the configuration source code", redactIfSensitive(path, marks...))
+				}
+				return cty.UnknownVal(cty.String), fmt.Errorf("failed to resolve %s: %w", redactIfSensitive(path, marks...), err)
+			}
+			if !pathWithin(root, dir) {
+				return cty.UnknownVal(cty.String), fmt.Errorf("%s is outside of the module directory", redactIfSensitive(path, marks...))
+			}
+			if fi, err := os.Stat(dir); err != nil {
+				return cty.UnknownVal(cty.String), fmt.Errorf("failed to stat %s: %w", redactIfSensitive(path, marks...), err)
+			} else if !fi.IsDir() {
+				return cty.UnknownVal(cty.String), fmt.Errorf("%s is not a directory", redactIfSensitive(path, marks...))
+			}
+
+			w := dirHashWalker{
+				root:    root,
+				pattern: pattern,
+				files:   make(map[string]string),
+				marks:   marks,
+			}
+			if err := w.walk(dir, "", nil); err != nil {
+				return cty.UnknownVal(cty.String), err
+			}
+
+			names := make([]string, 0, len(w.files))
+			for name := range w.files {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			summary := sha256.New()
+			for _, name := range names {
+				if strings.Contains(name, "\n") {
+					return cty.UnknownVal(cty.String), fmt.Errorf("cannot hash file %s because its name contains a newline", redactIfSensitive(name, marks...))
+				}
+				f, err := os.Open(w.files[name])
+				if err != nil {
+					return cty.UnknownVal(cty.String), fmt.Errorf("failed to open %s: %w", redactIfSensitive(name, marks...), err)
+				}
+				h := sha256.New()
+				_, err = io.Copy(h, f)
+				f.Close()
+				if err != nil {
+					return cty.UnknownVal(cty.String), fmt.Errorf("failed to read %s: %w", redactIfSensitive(name, marks...), err)
+				}
+				fmt.Fprintf(summary, "%x  %s\n", h.Sum(nil), name)
+			}
+
+			return cty.StringVal("h1:" + base64.StdEncoding.EncodeToString(summary.Sum(nil))).WithMarks(marks...), nil
+		},
+	})
+}
+
+// dirHashWalker collects the files to include in the result of the dirhash
+// function.
+type dirHashWalker struct {
+	root    string
+	pattern string
+	marks   []cty.ValueMarks
+
+	// files maps the slash-separated path of each matching file, relative to
+	// the hashed directory, to its location on disk.
+	files map[string]string
+}
+
+// walk visits the directory at the given real path, whose path relative to
+// the hashed directory is rel. parents contains the real paths of the
+// directories being visited, which is how symlink loops are detected.
+func (w *dirHashWalker) walk(dir, rel string, parents []string) error {
+	parents = append(parents, dir)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory %s: %w", redactIfSensitive(filepath.Join(".", rel), w.marks...), err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if rel != "" {
+			name = rel + "/" + name
+		}
+		path := filepath.Join(dir, entry.Name())
+
+		if entry.Type()&os.ModeSymlink != 0 {
+			target, err := filepath.EvalSymlinks(path)
+			if err != nil {
+				return fmt.Errorf("failed to resolve symbolic link %s: %w", redactIfSensitive(name, w.marks...), err)
+			}
+			if !pathWithin(w.root, target) {
+				return fmt.Errorf("symbolic link %s refers to a location outside of the module directory", redactIfSensitive(name, w.marks...))
+			}
+			path = target
+		}
+
+		fi, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", redactIfSensitive(name, w.marks...), err)
+		}
+		switch {
+		case fi.IsDir():
+			for _, parent := range parents {
+				if parent == path {
+					return fmt.Errorf("symbolic link %s creates a loop", redactIfSensitive(name, w.marks...))
+				}
+			}
+			if err := w.walk(path, name, parents); err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular():
+			match, err := doublestar.Match(w.pattern, name)
+			if err != nil {
+				return fmt.Errorf("failed to match pattern %s: %w", redactIfSensitive(w.pattern, w.marks...), err)
+			}
+			if match {
+				w.files[name] = path
+			}
+		}
+	}
+	return nil
+}
+
+// pathWithin returns true if path is dir or is inside dir. Both must be
+// absolute, cleaned paths.
+func pathWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // BasenameFunc constructs a function that takes a string containing a filesystem path
 // and removes all except the last portion from it.
 var BasenameFunc = function.New(&function.Spec{
@@ -485,6 +673,16 @@ func FileSet(baseDir string, path, pattern cty.Value) (cty.Value, error) {
 	return fn.Call([]cty.Value{path, pattern})
 }
 
+// DirHash computes a hash of the files in a directory that match a glob pattern.
+//
+// The underlying function implementation works relative to a particular base
+// directory, so this wrapper takes a base directory string and uses it to
+// construct the underlying function before calling it.
+func DirHash(baseDir string, path, pattern cty.Value) (cty.Value, error) {
+	fn := MakeDirHashFunc(baseDir)
+	return fn.Call([]cty.Value{path, pattern})
+}
+
 // FileBase64 reads the contents of the file at the given path.
 //
 // The bytes from the file are encoded as base64 before returning.
@@ -8,6 +8,7 @@ package funcs
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
 	"github.com/zclconf/go-cty/cty/function/stdlib"
+	"golang.org/x/mod/sumdb/dirhash"
 
 	"github.com/opentofu/opentofu/internal/lang/marks"
 )
@@ -574,6 +576,209 @@ func TestFileSet(t *testing.T) {
 	}
 }
 
+func TestDirHash(t *testing.T) {
+	baseDir := t.TempDir()
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		t.Helper()
+		for name, content := range files {
+			path := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeFiles(t, baseDir, map[string]string{
+		"site/index.html":       "<h1>Hello</h1>",
+		"site/css/main.css":     "h1 {}",
+		"site/css/print.css":    "",
+		"site/img/logo.txt":     "logo",
+		"copy/css/main.css":     "h1 {}",
+		"copy/img/logo.txt":     "logo",
+		"copy/index.html":       "<h1>Hello</h1>",
+		"copy/css/print.css":    "",
+		"changed/index.html":    "<h1>Goodbye</h1>",
+		"changed/css/main.css":  "h1 {}",
+		"changed/css/print.css": "",
+		"changed/img/logo.txt":  "logo",
+	})
+
+	// The expected results use the same scheme as Go module checksums.
+	want := func(t *testing.T, dir string, names ...string) cty.Value {
+		t.Helper()
+		h, err := dirhash.Hash1(names, func(name string) (io.ReadCloser, error) {
+			return os.Open(filepath.Join(baseDir, dir, filepath.FromSlash(name)))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cty.StringVal(h)
+	}
+
+	tests := map[string]struct {
+		Path    cty.Value
+		Pattern cty.Value
+		Want    cty.Value
+	}{
+		"all files": {
+			cty.StringVal("site"),
+			cty.StringVal("**"),
+			want(t, "site", "css/main.css", "css/print.css", "img/logo.txt", "index.html"),
+		},
+		"filtered": {
+			cty.StringVal("site"),
+			cty.StringVal("**/*.css"),
+			want(t, "site", "css/main.css", "css/print.css"),
+		},
+		"top level only": {
+			cty.StringVal("site"),
+			cty.StringVal("*"),
+			want(t, "site", "index.html"),
+		},
+		"same content in another directory": {
+			cty.StringVal("copy"),
+			cty.StringVal("**"),
+			want(t, "site", "css/main.css", "css/print.css", "img/logo.txt", "index.html"),
+		},
+		"absolute path": {
+			cty.StringVal(filepath.Join(baseDir, "site")),
+			cty.StringVal("**"),
+			want(t, "site", "css/main.css", "css/print.css", "img/logo.txt", "index.html"),
+		},
+		"no matches": {
+			cty.StringVal("site"),
+			cty.StringVal("*.md"),
+			want(t, "site"),
+		},
+		"sensitive pattern": {
+			cty.StringVal("site"),
+			cty.StringVal("*").Mark(marks.Sensitive),
+			want(t, "site", "index.html").Mark(marks.Sensitive),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := DirHash(baseDir, test.Path, test.Pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.RawEquals(test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+
+	t.Run("content changed", func(t *testing.T) {
+		site, err := DirHash(baseDir, cty.StringVal("site"), cty.StringVal("**"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		changed, err := DirHash(baseDir, cty.StringVal("changed"), cty.StringVal("**"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if site.RawEquals(changed) {
+			t.Errorf("same hash for different content: %#v", site)
+		}
+	})
+}
+
+func TestDirHash_symlinks(t *testing.T) {
+	outside := t.TempDir()
+	baseDir := t.TempDir()
+	for _, dir := range []string{"linked/sub", "loop/sub"} {
+		if err := os.MkdirAll(filepath.Join(baseDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{
+		filepath.Join(baseDir, "linked", "sub", "a.txt"),
+		filepath.Join(outside, "secret.txt"),
+	} {
+		if err := os.WriteFile(path, []byte("hello"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		filepath.Join(baseDir, "linked", "other"):    filepath.Join(baseDir, "linked", "sub"),
+		filepath.Join(baseDir, "loop", "sub", "up"):  filepath.Join(baseDir, "loop"),
+		filepath.Join(baseDir, "escape"):             outside,
+		filepath.Join(baseDir, "linked-escape", "x"): filepath.Join(outside, "secret.txt"),
+	}
+	for link, target := range links {
+		if err := os.MkdirAll(filepath.Dir(link), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(target, link); err != nil {
+			t.Skipf("can't create symlinks: %s", err)
+		}
+	}
+
+	t.Run("followed", func(t *testing.T) {
+		got, err := DirHash(baseDir, cty.StringVal("linked"), cty.StringVal("**"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		h, err := dirhash.Hash1([]string{"other/a.txt", "sub/a.txt"}, func(string) (io.ReadCloser, error) {
+			return os.Open(filepath.Join(baseDir, "linked", "sub", "a.txt"))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := cty.StringVal(h); !got.RawEquals(want) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+
+	errTests := map[string]struct {
+		Path cty.Value
+		Want string
+	}{
+		"loop": {
+			cty.StringVal("loop"),
+			`symbolic link "sub/up" creates a loop`,
+		},
+		"directory outside the module": {
+			cty.StringVal("escape"),
+			`"escape" is outside of the module directory`,
+		},
+		"relative path outside the module": {
+			cty.StringVal(".."),
+			`".." is outside of the module directory`,
+		},
+		"link outside the module": {
+			cty.StringVal("linked-escape"),
+			`symbolic link "x" refers to a location outside of the module directory`,
+		},
+		"missing": {
+			cty.StringVal("missing"),
+			`no directory exists at "missing"; this function works only with files that are distributed as part of the configuration source code`,
+		},
+		"sensitive": {
+			cty.StringVal("missing").Mark(marks.Sensitive),
+			`no directory exists at (sensitive value); this function works only with files that are distributed as part of the configuration source code`,
+		},
+		"not a directory": {
+			cty.StringVal("linked/sub/a.txt"),
+			`"linked/sub/a.txt" is not a directory`,
+		},
+	}
+	for name, test := range errTests {
+		t.Run(name, func(t *testing.T) {
+			_, err := DirHash(baseDir, test.Path, cty.StringVal("**"))
+			if err == nil {
+				t.Fatal("succeeded; want error")
+			}
+			if got := err.Error(); got != test.Want {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.Want)
+			}
+		})
+	}
+}
+
 func TestFileBase64(t *testing.T) {
 	tests := []struct {
 		Path cty.Value
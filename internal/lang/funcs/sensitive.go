@@ -60,6 +60,62 @@ var NonsensitiveFunc = function.New(&function.Spec{
 	},
 })
 
+// SensitiveValuesFunc takes a map or object and returns a value with the same
+// keys or attributes, but with each of its elements marked as sensitive.
+//
+// Unlike SensitiveFunc, the collection itself is not marked and so its keys
+// remain visible in the plan output.
+var SensitiveValuesFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{
+			Name:             "value",
+			Type:             cty.DynamicPseudoType,
+			AllowUnknown:     true,
+			AllowNull:        true,
+			AllowMarked:      true,
+			AllowDynamicType: true,
+		},
+	},
+	Type: func(args []cty.Value) (cty.Type, error) {
+		// This function only affects the value's marks, so the result
+		// type is always the same as the argument type.
+		ty := args[0].Type()
+		if !ty.IsMapType() && !ty.IsObjectType() && ty != cty.DynamicPseudoType {
+			return cty.NilType, function.NewArgErrorf(0, "must be a map or object, not %s", ty.FriendlyName())
+		}
+		return ty, nil
+	},
+	Impl: func(args []cty.Value, retType cty.Type) (ret cty.Value, err error) {
+		val, valMarks := args[0].Unmark()
+
+		// If we don't know the elements yet then we can't mark them
+		// individually, so we conservatively mark the whole value until
+		// more is known.
+		if !val.IsKnown() {
+			return val.WithMarks(valMarks).Mark(marks.Sensitive), nil
+		}
+		if val.IsNull() {
+			return val.WithMarks(valMarks), nil
+		}
+
+		elems := make(map[string]cty.Value, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			elems[k.AsString()] = v.Mark(marks.Sensitive)
+		}
+
+		switch {
+		case retType.IsObjectType():
+			ret = cty.ObjectVal(elems)
+		case len(elems) == 0:
+			ret = cty.MapValEmpty(retType.ElementType())
+		default:
+			ret = cty.MapVal(elems)
+		}
+		return ret.WithMarks(valMarks), nil
+	},
+})
+
 // IsSensitiveFunc returns whether or not the value is sensitive.
 var IsSensitiveFunc = function.New(&function.Spec{
 	Params: []function.Parameter{
@@ -88,6 +144,10 @@ func Nonsensitive(v cty.Value) (cty.Value, error) {
 	return NonsensitiveFunc.Call([]cty.Value{v})
 }
 
+func SensitiveValues(v cty.Value) (cty.Value, error) {
+	return SensitiveValuesFunc.Call([]cty.Value{v})
+}
+
 func IsSensitive(v cty.Value) (cty.Value, error) {
 	return IsSensitiveFunc.Call([]cty.Value{v})
 }
@@ -183,6 +183,126 @@ func TestNonsensitive(t *testing.T) {
 	}
 }
 
+func TestSensitiveValues(t *testing.T) {
+	tests := []struct {
+		Input   cty.Value
+		Want    cty.Value
+		WantErr string
+	}{
+		{
+			cty.MapVal(map[string]cty.Value{
+				"us-east-1": cty.StringVal("secret1"),
+				"eu-west-1": cty.StringVal("secret2"),
+			}),
+			cty.MapVal(map[string]cty.Value{
+				"us-east-1": cty.StringVal("secret1").Mark(marks.Sensitive),
+				"eu-west-1": cty.StringVal("secret2").Mark(marks.Sensitive),
+			}),
+			``,
+		},
+		{
+			cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.StringVal("a"),
+				"count": cty.NumberIntVal(1),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.StringVal("a").Mark(marks.Sensitive),
+				"count": cty.NumberIntVal(1).Mark(marks.Sensitive),
+			}),
+			``,
+		},
+		{
+			// Elements that are already sensitive stay sensitive
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.StringVal("b").Mark(marks.Sensitive),
+			}),
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.StringVal("b").Mark(marks.Sensitive),
+			}),
+			``,
+		},
+		{
+			// Unknown elements become sensitive unknown elements
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.UnknownVal(cty.String),
+			}),
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.UnknownVal(cty.String).Mark(marks.Sensitive),
+			}),
+			``,
+		},
+		{
+			// A whole-value sensitive mark is preserved
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.StringVal("b"),
+			}).Mark(marks.Sensitive),
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.StringVal("b").Mark(marks.Sensitive),
+			}).Mark(marks.Sensitive),
+			``,
+		},
+		{
+			cty.MapValEmpty(cty.String),
+			cty.MapValEmpty(cty.String),
+			``,
+		},
+		{
+			cty.EmptyObjectVal,
+			cty.EmptyObjectVal,
+			``,
+		},
+		{
+			cty.NullVal(cty.Map(cty.String)),
+			cty.NullVal(cty.Map(cty.String)),
+			``,
+		},
+		{
+			// We can't mark the elements of an unknown map individually,
+			// so the whole value becomes sensitive instead.
+			cty.UnknownVal(cty.Map(cty.String)),
+			cty.UnknownVal(cty.Map(cty.String)).Mark(marks.Sensitive),
+			``,
+		},
+		{
+			cty.DynamicVal,
+			cty.DynamicVal.Mark(marks.Sensitive),
+			``,
+		},
+		{
+			cty.StringVal("a"),
+			cty.NilVal,
+			`must be a map or object, not string`,
+		},
+		{
+			cty.ListVal([]cty.Value{cty.StringVal("a")}),
+			cty.NilVal,
+			`must be a map or object, not list of string`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("sensitivevalues(%#v)", test.Input), func(t *testing.T) {
+			got, err := SensitiveValues(test.Input)
+
+			if test.WantErr != "" {
+				if err == nil {
+					t.Fatal("succeeded; want error")
+				}
+				if got, want := err.Error(), test.WantErr; got != want {
+					t.Fatalf("wrong error\ngot:  %s\nwant: %s", got, want)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.RawEquals(test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
 func TestIsSensitive(t *testing.T) {
 	tests := []struct {
 		Input       cty.Value
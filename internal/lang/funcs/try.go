@@ -0,0 +1,78 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/ext/customdecode"
+	"github.com/hashicorp/hcl/v2/ext/tryfunc"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// TryFunc is a variadic function that tries to evaluate all of its arguments
+// in sequence until one succeeds, in which case it returns that result, or
+// returns an error if none of them succeed.
+//
+// It wraps the function of the same name in HCL's tryfunc extension, adding
+// to its error the argument each of the underlying problems came from.
+var TryFunc = function.New(&function.Spec{
+	VarParam: &function.Parameter{
+		Name: "expressions",
+		Type: customdecode.ExpressionClosureType,
+	},
+	Type: func(args []cty.Value) (cty.Type, error) {
+		ty, err := tryfunc.TryFunc.ReturnTypeForValues(args)
+		if err != nil {
+			return cty.NilType, tryArgumentsError(args, err)
+		}
+		return ty, nil
+	},
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		v, err := tryfunc.TryFunc.Call(args)
+		if err != nil {
+			return cty.NilVal, tryArgumentsError(args, err)
+		}
+		return v, nil
+	},
+})
+
+// tryArgumentsError describes the problems with each of the given arguments,
+// which tryfunc.TryFunc has reported as all failing with the given error.
+//
+// The upstream error doesn't say which argument each problem came from, so
+// we evaluate the arguments again and describe their diagnostics ourselves.
+// This only happens once every argument has failed, so it doesn't affect
+// the cost of a successful call.
+func tryArgumentsError(args []cty.Value, err error) error {
+	var buf strings.Builder
+	buf.WriteString("no expression succeeded:\n")
+	found := false
+	for i, arg := range args {
+		_, diags := customdecode.ExpressionClosureFromVal(arg).Value()
+		for _, diag := range diags {
+			found = true
+			fmt.Fprintf(&buf, "- argument %d: %s", i+1, diag.Summary)
+			if diag.Subject != nil {
+				fmt.Fprintf(&buf, " (at %s)", diag.Subject)
+			}
+			buf.WriteString("\n")
+			if diag.Detail != "" {
+				fmt.Fprintf(&buf, "  %s\n", diag.Detail)
+			}
+		}
+	}
+	if !found {
+		// The error isn't about the arguments' expressions, such as when
+		// there are no arguments at all, so there is nothing to add to it.
+		return err
+	}
+	buf.WriteString("\nAt least one expression must produce a successful result")
+	return errors.New(buf.String())
+}
@@ -0,0 +1,98 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package funcs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+func TestTry(t *testing.T) {
+	tests := []struct {
+		Expr    string
+		Want    cty.Value
+		WantErr []string
+	}{
+		{
+			`try(obj.a)`,
+			cty.StringVal("a"),
+			nil,
+		},
+		{
+			`try(obj.b, obj.a)`,
+			cty.StringVal("a"),
+			nil,
+		},
+		{
+			`try(unknown.a, obj.a)`,
+			cty.DynamicVal,
+			nil,
+		},
+		{
+			`try(obj.b, list[5], obj.a + 1)`,
+			cty.NilVal,
+			[]string{
+				`- argument 1: Unsupported attribute (at test.tf:1,8-10)`,
+				`  This object does not have an attribute named "b".`,
+				`- argument 2: Invalid index (at test.tf:1,16-19)`,
+				`- argument 3: Invalid operand (at test.tf:1,21-26)`,
+				`At least one expression must produce a successful result`,
+			},
+		},
+		{
+			`try()`,
+			cty.NilVal,
+			[]string{
+				`at least one argument is required`,
+			},
+		},
+	}
+
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"obj":     cty.ObjectVal(map[string]cty.Value{"a": cty.StringVal("a")}),
+			"list":    cty.ListVal([]cty.Value{cty.StringVal("x")}),
+			"unknown": cty.UnknownVal(cty.Object(map[string]cty.Type{"a": cty.String})),
+		},
+		Functions: map[string]function.Function{
+			"try": TryFunc,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Expr, func(t *testing.T) {
+			expr, diags := hclsyntax.ParseExpression([]byte(test.Expr), "test.tf", hcl.Pos{Line: 1, Column: 1})
+			if diags.HasErrors() {
+				t.Fatalf("invalid test expression: %s", diags.Error())
+			}
+
+			got, diags := expr.Value(ctx)
+			if test.WantErr != nil {
+				if !diags.HasErrors() {
+					t.Fatalf("succeeded with %#v; want error", got)
+				}
+				msg := diags.Error()
+				for _, want := range test.WantErr {
+					if !strings.Contains(msg, want) {
+						t.Errorf("missing %q in error:\n%s", want, msg)
+					}
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatalf("unexpected error: %s", diags.Error())
+			}
+			if !got.RawEquals(test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
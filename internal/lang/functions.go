@@ -67,6 +67,7 @@ func (s *Scope) Functions() map[string]function.Function {
 			"concat":           stdlib.ConcatFunc,
 			"contains":         stdlib.ContainsFunc,
 			"csvdecode":        stdlib.CSVDecodeFunc,
+			"dirhash":          funcs.MakeDirHashFunc(s.BaseDir),
 			"dirname":          funcs.DirnameFunc,
 			"distinct":         stdlib.DistinctFunc,
 			"element":          stdlib.ElementFunc,
@@ -117,6 +118,7 @@ func (s *Scope) Functions() map[string]function.Function {
 			"sensitive":        funcs.SensitiveFunc,
 			"nonsensitive":     funcs.NonsensitiveFunc,
 			"issensitive":      funcs.IsSensitiveFunc,
+			"sensitivevalues":  funcs.SensitiveValuesFunc,
 			"setintersection":  stdlib.SetIntersectionFunc,
 			"setproduct":       stdlib.SetProductFunc,
 			"setsubtract":      stdlib.SetSubtractFunc,
@@ -150,7 +152,7 @@ func (s *Scope) Functions() map[string]function.Function {
 			"trimprefix":       stdlib.TrimPrefixFunc,
 			"trimspace":        stdlib.TrimSpaceFunc,
 			"trimsuffix":       stdlib.TrimSuffixFunc,
-			"try":              tryfunc.TryFunc,
+			"try":              funcs.TryFunc,
 			"upper":            stdlib.UpperFunc,
 			"urlencode":        funcs.URLEncodeFunc,
 			"urldecode":        funcs.URLDecodeFunc,
@@ -313,6 +313,13 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 
+		"dirhash": {
+			{
+				`dirhash(".", "*/hello.txt")`,
+				cty.StringVal("h1:w1Px6bDBCmd914YkWXgCFQxdwnDunSIEJwGgVVZrG0E="),
+			},
+		},
+
 		"dirname": {
 			{
 				`dirname("testdata/hello.txt")`,
@@ -555,6 +562,16 @@ func TestFunctions(t *testing.T) {
 					"hello": cty.StringVal("world"),
 				}),
 			},
+			{
+				// Decoding a sensitive string makes the whole result sensitive,
+				// rather than any of the values nested inside it.
+				`jsondecode(sensitive("{\"hello\": [\"world\"]}"))`,
+				cty.ObjectVal(map[string]cty.Value{
+					"hello": cty.TupleVal([]cty.Value{
+						cty.StringVal("world"),
+					}),
+				}).Mark(marks.Sensitive),
+			},
 		},
 
 		"jsonencode": {
@@ -568,6 +585,24 @@ func TestFunctions(t *testing.T) {
 				`jsonencode({"hello"="<cats & kittens>"})`,
 				cty.StringVal("{\"hello\":\"\\u003ccats \\u0026 kittens\\u003e\"}"),
 			},
+			{
+				`jsonencode(sensitive({"hello"="world"}))`,
+				cty.StringVal("{\"hello\":\"world\"}").Mark(marks.Sensitive),
+			},
+			{
+				// A sensitive value nested anywhere makes the whole result
+				// sensitive.
+				`jsonencode({"a"={"b"=[1, {"c"=sensitive("secret")}]}})`,
+				cty.StringVal("{\"a\":{\"b\":[1,{\"c\":\"secret\"}]}}").Mark(marks.Sensitive),
+			},
+			{
+				`jsondecode(jsonencode({"a"=[sensitive("secret")]}))`,
+				cty.ObjectVal(map[string]cty.Value{
+					"a": cty.TupleVal([]cty.Value{
+						cty.StringVal("secret"),
+					}),
+				}).Mark(marks.Sensitive),
+			},
 		},
 
 		"keys": {
@@ -789,6 +824,19 @@ func TestFunctions(t *testing.T) {
 			},
 		},
 
+		"sensitivevalues": {
+			{
+				`sensitivevalues({a = 1})`,
+				cty.ObjectVal(map[string]cty.Value{
+					"a": cty.NumberIntVal(1).Mark(marks.Sensitive),
+				}),
+			},
+			{
+				`sensitivevalues(tomap({a = "b"}))["a"]`,
+				cty.StringVal("b").Mark(marks.Sensitive),
+			},
+		},
+
 		"setintersection": {
 			{
 				`setintersection(["a", "b"], ["b", "c"], ["b", "d"])`,
@@ -26,3 +26,27 @@ const (
 func (a Action) IsReplace() bool {
 	return a == DeleteThenCreate || a == CreateThenDelete
 }
+
+// Verb returns a lowercase verb describing the action, such as "create" or
+// "replace", for use in messages written for humans. Both replace actions
+// are described as "replace".
+func (a Action) Verb() string {
+	switch a {
+	case NoOp:
+		return "no-op"
+	case Create:
+		return "create"
+	case Read:
+		return "read"
+	case Update:
+		return "update"
+	case DeleteThenCreate, CreateThenDelete:
+		return "replace"
+	case Delete:
+		return "delete"
+	case Forget:
+		return "forget"
+	default:
+		return a.String()
+	}
+}
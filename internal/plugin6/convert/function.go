@@ -66,3 +66,54 @@ func ProtoToFunctionSpec(proto *tfplugin6.Function) providers.FunctionSpec {
 		DeprecationMessage: proto.DeprecationMessage,
 	}
 }
+
+func CtyTypeToProto(ty cty.Type) []byte {
+	out, err := json.Marshal(ty)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func TextFormattingToProto(format providers.TextFormatting) tfplugin6.StringKind {
+	switch format {
+	case providers.TextFormattingMarkdown:
+		return tfplugin6.StringKind_MARKDOWN
+	default:
+		return tfplugin6.StringKind_PLAIN
+	}
+}
+
+func FunctionParameterSpecToProto(spec providers.FunctionParameterSpec) *tfplugin6.Function_Parameter {
+	return &tfplugin6.Function_Parameter{
+		Name:               spec.Name,
+		Type:               CtyTypeToProto(spec.Type),
+		AllowNullValue:     spec.AllowNullValue,
+		AllowUnknownValues: spec.AllowUnknownValues,
+		Description:        spec.Description,
+		DescriptionKind:    TextFormattingToProto(spec.DescriptionFormat),
+	}
+}
+
+// FunctionSpecToProto is the inverse of ProtoToFunctionSpec.
+func FunctionSpecToProto(spec providers.FunctionSpec) *tfplugin6.Function {
+	params := make([]*tfplugin6.Function_Parameter, len(spec.Parameters))
+	for i, param := range spec.Parameters {
+		params[i] = FunctionParameterSpecToProto(param)
+	}
+
+	var varParam *tfplugin6.Function_Parameter
+	if spec.VariadicParameter != nil {
+		varParam = FunctionParameterSpecToProto(*spec.VariadicParameter)
+	}
+
+	return &tfplugin6.Function{
+		Parameters:         params,
+		VariadicParameter:  varParam,
+		Return:             &tfplugin6.Function_Return{Type: CtyTypeToProto(spec.Return)},
+		Summary:            spec.Summary,
+		Description:        spec.Description,
+		DescriptionKind:    TextFormattingToProto(spec.DescriptionFormat),
+		DeprecationMessage: spec.DeprecationMessage,
+	}
+}
@@ -96,6 +96,15 @@ func protoSchemaNestedBlock(name string, b *configschema.NestedBlock) *proto.Sch
 	}
 }
 
+// ProviderSchemaToProto takes a providers.Schema and converts it to a
+// proto.Schema, as the inverse of ProtoToProviderSchema.
+func ProviderSchemaToProto(s providers.Schema) *proto.Schema {
+	return &proto.Schema{
+		Version: s.Version,
+		Block:   ConfigSchemaToProto(s.Block),
+	}
+}
+
 // ProtoToProviderSchema takes a proto.Schema and converts it to a providers.Schema.
 func ProtoToProviderSchema(s *proto.Schema) providers.Schema {
 	return providers.Schema{
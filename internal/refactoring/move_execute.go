@@ -95,7 +95,16 @@ func ApplyMoves(stmts []MoveStatement, state *states.State) MoveResults {
 	for _, v := range g.ReverseTopologicalOrder() {
 		stmt := v.(*MoveStatement)
 
+		// The moves below add and remove entries in state.Modules, so we
+		// take a snapshot of the module instances first to make sure that
+		// each one is visited exactly once, and that we never visit a
+		// module instance that was only just created by this statement.
+		modules := make([]*states.Module, 0, len(state.Modules))
 		for _, ms := range state.Modules {
+			modules = append(modules, ms)
+		}
+
+		for _, ms := range modules {
 			modAddr := ms.Addr
 
 			// We don't yet know that the current module is relevant, and
@@ -177,6 +177,88 @@ func TestApplyMoves(t *testing.T) {
 			},
 		},
 
+		"move indexed resource into nested module": {
+			[]MoveStatement{
+				testMoveStatement(t, "", "foo.from", "module.outer.module.inner.foo.to"),
+			},
+			states.BuildState(func(s *states.SyncState) {
+				s.SetResourceInstanceCurrent(
+					mustParseInstAddr("foo.from[0]"),
+					&states.ResourceInstanceObjectSrc{
+						Status:    states.ObjectReady,
+						AttrsJSON: []byte(`{}`),
+					},
+					providerAddr,
+				)
+				s.SetResourceInstanceCurrent(
+					mustParseInstAddr("foo.from[1]"),
+					&states.ResourceInstanceObjectSrc{
+						Status:    states.ObjectReady,
+						AttrsJSON: []byte(`{}`),
+					},
+					providerAddr,
+				)
+			}),
+			MoveResults{
+				Changes: addrs.MakeMap(
+					addrs.MakeMapElem(mustParseInstAddr("module.outer.module.inner.foo.to[0]"), MoveSuccess{
+						From: mustParseInstAddr("foo.from[0]"),
+						To:   mustParseInstAddr("module.outer.module.inner.foo.to[0]"),
+					}),
+					addrs.MakeMapElem(mustParseInstAddr("module.outer.module.inner.foo.to[1]"), MoveSuccess{
+						From: mustParseInstAddr("foo.from[1]"),
+						To:   mustParseInstAddr("module.outer.module.inner.foo.to[1]"),
+					}),
+				),
+				Blocked: emptyResults.Blocked,
+			},
+			[]string{
+				`module.outer.module.inner.foo.to[0]`,
+				`module.outer.module.inner.foo.to[1]`,
+			},
+		},
+
+		"move module with indexed resources and child module into nested module": {
+			[]MoveStatement{
+				testMoveStatement(t, "", "module.boo", "module.outer.module.inner"),
+			},
+			states.BuildState(func(s *states.SyncState) {
+				s.SetResourceInstanceCurrent(
+					mustParseInstAddr(`module.boo.foo.from["a"]`),
+					&states.ResourceInstanceObjectSrc{
+						Status:    states.ObjectReady,
+						AttrsJSON: []byte(`{}`),
+					},
+					providerAddr,
+				)
+				s.SetResourceInstanceCurrent(
+					mustParseInstAddr(`module.boo.module.hoo[0].foo.from[1]`),
+					&states.ResourceInstanceObjectSrc{
+						Status:    states.ObjectReady,
+						AttrsJSON: []byte(`{}`),
+					},
+					providerAddr,
+				)
+			}),
+			MoveResults{
+				Changes: addrs.MakeMap(
+					addrs.MakeMapElem(mustParseInstAddr(`module.outer.module.inner.foo.from["a"]`), MoveSuccess{
+						From: mustParseInstAddr(`module.boo.foo.from["a"]`),
+						To:   mustParseInstAddr(`module.outer.module.inner.foo.from["a"]`),
+					}),
+					addrs.MakeMapElem(mustParseInstAddr(`module.outer.module.inner.module.hoo[0].foo.from[1]`), MoveSuccess{
+						From: mustParseInstAddr(`module.boo.module.hoo[0].foo.from[1]`),
+						To:   mustParseInstAddr(`module.outer.module.inner.module.hoo[0].foo.from[1]`),
+					}),
+				),
+				Blocked: emptyResults.Blocked,
+			},
+			[]string{
+				`module.outer.module.inner.foo.from["a"]`,
+				`module.outer.module.inner.module.hoo[0].foo.from[1]`,
+			},
+		},
+
 		"move resource instance between modules": {
 			[]MoveStatement{
 				testMoveStatement(t, "", "module.boo.foo.from[0]", "module.bar[0].foo.to[0]"),
@@ -58,6 +58,11 @@ func ValidateMoves(stmts []MoveStatement, rootCfg *configs.Config, declaredInsts
 		// both stmt.From and stmt.To always belong to the same statement.
 		fromMod, _ := stmt.From.ModuleCallTraversals()
 
+		// The destination module is a static property of the configuration,
+		// so we only report a missing one once per statement, even if the
+		// statement is declared in a module with multiple instances.
+		reportedMissingModule := false
+
 		for _, fromModInst := range declaredInsts.InstancesForModule(fromMod) {
 			absFrom := stmt.From.InModuleInstance(fromModInst)
 
@@ -161,6 +166,34 @@ func ValidateMoves(stmts []MoveStatement, rootCfg *configs.Config, declaredInsts
 				})
 			}
 
+			// A move into a different module requires all of the module calls
+			// leading to the destination to already be declared in the
+			// configuration, unless another statement moves that module
+			// somewhere else in turn.
+			if !reportedMissingModule {
+				if callAddr, missing := missingMoveDestinationModuleCall(absFrom, absTo, rootCfg, stmts); missing {
+					reportedMissingModule = true
+
+					parentName := "the root module"
+					if !callAddr.Module.IsRoot() {
+						parentName = callAddr.Module.String()
+					}
+					subject := stmt.To.SourceRange
+					if subject.Filename == "" {
+						subject = stmt.DeclRange
+					}
+					diags = diags.Append(&hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Move destination module does not exist",
+						Detail: fmt.Sprintf(
+							"This statement declares a move from %s to %s, but %s has no module call named %q.\n\nAdd a module block for %s to the configuration before moving objects into it.",
+							absFrom, absTo, parentName, callAddr.Call.Name, callAddr,
+						),
+						Subject: subject.ToHCL().Ptr(),
+					})
+				}
+			}
+
 			// Resource types must match.
 			if resourceTypesDiffer(absFrom, absTo) {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -256,6 +289,66 @@ func moveableObjectExists(addr addrs.AbsMoveable, in instances.Set) bool {
 	}
 }
 
+// missingMoveDestinationModuleCall checks whether a move from absFrom to
+// absTo crosses into a module that isn't declared in the configuration.
+//
+// If so, it returns the first module call along the path to the destination
+// that has no corresponding module block, and true. Moves within a single
+// module, and moves into a module that another statement moves elsewhere,
+// are never reported.
+func missingMoveDestinationModuleCall(absFrom, absTo addrs.AbsMoveable, rootCfg *configs.Config, stmts []MoveStatement) (addrs.AbsModuleCall, bool) {
+	fromMod := moveableContainingModule(absFrom)
+	toMod := moveableContainingModule(absTo)
+	if fromMod.Module().Equal(toMod.Module()) {
+		return addrs.AbsModuleCall{}, false
+	}
+
+	for i := range toMod {
+		modCfg := rootCfg.Descendent(toMod[:i].Module())
+		if modCfg == nil {
+			// Should not get here, because we'd have returned on an earlier
+			// iteration.
+			break
+		}
+		if _, exists := modCfg.Module.ModuleCalls[toMod[i].Name]; exists {
+			continue
+		}
+
+		// The destination might not be declared because it's the source of
+		// another chained move, in which case the objects won't stay here.
+		for j := i + 1; j <= len(toMod); j++ {
+			for _, stmt := range stmts {
+				if stmt.ObjectKind() == addrs.MoveEndpointModule && stmt.From.SelectsModule(toMod[:j]) {
+					return addrs.AbsModuleCall{}, false
+				}
+			}
+		}
+
+		return addrs.ModuleCall{Name: toMod[i].Name}.Absolute(toMod[:i]), true
+	}
+	return addrs.AbsModuleCall{}, false
+}
+
+// moveableContainingModule returns the module instance that declares the
+// object the given address refers to.
+func moveableContainingModule(addr addrs.AbsMoveable) addrs.ModuleInstance {
+	switch addr := addr.(type) {
+	case addrs.ModuleInstance:
+		// NOTE: This assumes "addr" can never be the root module instance,
+		// because the root module is never moveable.
+		return addr.Parent()
+	case addrs.AbsModuleCall:
+		return addr.Module
+	case addrs.AbsResourceInstance:
+		return addr.Module
+	case addrs.AbsResource:
+		return addr.Module
+	default:
+		// The above cases should cover all of the AbsMoveable types
+		panic("unsupported AbsMoveable address type")
+	}
+}
+
 func resourceTypesDiffer(absFrom, absTo addrs.AbsMoveable) bool {
 	switch absFrom := absFrom.(type) {
 	case addrs.AbsMoveableResource:
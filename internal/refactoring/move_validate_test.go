@@ -52,7 +52,7 @@ func TestValidateMoves(t *testing.T) {
 				makeTestMoveStmt(t,
 					``,
 					`test.nonexist2`,
-					`module.nonexist.test.nonexist2`,
+					`module.single.test.nonexist2`,
 				),
 				makeTestMoveStmt(t,
 					``,
@@ -102,7 +102,17 @@ func TestValidateMoves(t *testing.T) {
 				makeTestMoveStmt(t,
 					``,
 					`module.for_each["nonexist2"]`,
-					`module.nonexist.module.nonexist`,
+					`module.single.module.nonexist`,
+				),
+				makeTestMoveStmt(t,
+					``,
+					`test.nonexist5`,
+					`module.nonexist5.test.nonexist5`,
+				),
+				makeTestMoveStmt(t,
+					``,
+					`module.nonexist5`,
+					`module.single`,
 				),
 				makeTestMoveStmt(t,
 					``,
@@ -127,6 +137,42 @@ func TestValidateMoves(t *testing.T) {
 			},
 			WantError: ``,
 		},
+		"resource moved into undeclared module": {
+			Statements: []MoveStatement{
+				makeTestMoveStmt(t,
+					``,
+					`test.nonexist2`,
+					`module.nonexist.test.nonexist2`,
+				),
+			},
+			WantError: `Move destination module does not exist: This statement declares a move from test.nonexist2 to module.nonexist.test.nonexist2, but the root module has no module call named "nonexist".
+
+Add a module block for module.nonexist to the configuration before moving objects into it.`,
+		},
+		"module moved into undeclared nested module": {
+			Statements: []MoveStatement{
+				makeTestMoveStmt(t,
+					``,
+					`module.for_each["nonexist2"]`,
+					`module.single.module.nonexist.module.nonexist`,
+				),
+			},
+			WantError: `Move destination module does not exist: This statement declares a move from module.for_each["nonexist2"] to module.single.module.nonexist.module.nonexist, but module.single has no module call named "nonexist".
+
+Add a module block for module.single.module.nonexist to the configuration before moving objects into it.`,
+		},
+		"resource moved into undeclared module from module with multiple instances": {
+			Statements: []MoveStatement{
+				makeTestMoveStmt(t,
+					`count`,
+					`test.nonexist1`,
+					`module.nonexist.test.nonexist1`,
+				),
+			},
+			WantError: `Move destination module does not exist: This statement declares a move from module.count[0].test.nonexist1 to module.count[0].module.nonexist.test.nonexist1, but module.count[0] has no module call named "nonexist".
+
+Add a module block for module.count[0].module.nonexist to the configuration before moving objects into it.`,
+		},
 		"moving nowhere": {
 			Statements: []MoveStatement{
 				makeTestMoveStmt(t,
@@ -11,12 +11,15 @@ import (
 	"strings"
 
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/lang/types"
+	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
@@ -24,6 +27,12 @@ import (
 type Session struct {
 	// Scope is the evaluation scope where expressions will be evaluated.
 	Scope *lang.Scope
+
+	// ProviderFunctions are the functions offered by each of the providers
+	// required by the module, keyed by the local name of the provider, as
+	// returned by tofu.Context.ProviderFunctions. The "functions" command
+	// lists them along with the built-in functions.
+	ProviderFunctions map[string]providers.GetFunctionsResponse
 }
 
 // Handle handles a single line of input from the REPL.
@@ -41,6 +50,8 @@ func (s *Session) Handle(line string) (string, bool, tfdiags.Diagnostics) {
 	case strings.TrimSpace(line) == "help":
 		ret, diags := s.handleHelp()
 		return ret, false, diags
+	case strings.TrimSpace(line) == "functions":
+		return s.handleFunctions(), false, nil
 	default:
 		ret, diags := s.handleEval(line)
 		return ret, false, diags
@@ -97,6 +108,10 @@ to the ID of "aws_instance.foo" if it exists in your state.
 
 Type in the interpolation to test and hit <enter> to see the result.
 
+Type "functions" and hit <enter> to list the available functions, including
+those offered by the providers this configuration requires. When running
+interactively, hit <tab> to complete the name of a function.
+
 To exit the console, type "exit" and hit <enter>, or use Control-C or
 Control-D.
 `
@@ -104,6 +119,117 @@ Control-D.
 	return strings.TrimSpace(text), nil
 }
 
+func (s *Session) handleFunctions() string {
+	var b strings.Builder
+
+	b.WriteString("Built-in functions:\n")
+	funcs := s.Scope.Functions()
+	for _, name := range builtinFunctionNames(funcs) {
+		fn := funcs[name]
+		fmt.Fprintf(&b, "  %s\n", functionSignature(name, fn.Params(), fn.VarParam()))
+	}
+
+	for _, providerName := range sortedKeys(s.ProviderFunctions) {
+		resp := s.ProviderFunctions[providerName]
+		fmt.Fprintf(&b, "\nFunctions of provider %q:\n", providerName)
+		if resp.Diagnostics.HasErrors() {
+			fmt.Fprintf(&b, "  (unavailable: %s)\n", resp.Diagnostics.Err())
+			continue
+		}
+		if len(resp.Functions) == 0 {
+			b.WriteString("  (none)\n")
+			continue
+		}
+		for _, name := range sortedKeys(resp.Functions) {
+			spec := resp.Functions[name]
+			fullName := providerFunctionName(providerName, name)
+			fmt.Fprintf(&b, "  %s\n", providerFunctionSignature(fullName, spec))
+			if spec.Summary != "" {
+				fmt.Fprintf(&b, "      %s\n", spec.Summary)
+			}
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// FunctionNames returns the sorted names of all of the functions that can be
+// called in the session, including the functions of the providers in
+// ProviderFunctions, for completing function names as the user types.
+func (s *Session) FunctionNames() []string {
+	names := builtinFunctionNames(s.Scope.Functions())
+	for providerName, resp := range s.ProviderFunctions {
+		for name := range resp.Functions {
+			names = append(names, providerFunctionName(providerName, name))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// builtinFunctionNames returns the sorted names of the given built-in
+// functions, leaving out the duplicates in the core:: namespace.
+func builtinFunctionNames(funcs map[string]function.Function) []string {
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		if strings.HasPrefix(name, lang.CoreNamespace) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func providerFunctionName(providerName, name string) string {
+	return addrs.ProviderFunction{ProviderName: providerName, Function: name}.String()
+}
+
+func providerFunctionSignature(name string, spec providers.FunctionSpec) string {
+	params := make([]function.Parameter, len(spec.Parameters))
+	for i, param := range spec.Parameters {
+		params[i] = function.Parameter{Name: param.Name, Type: param.Type}
+	}
+	var varParam *function.Parameter
+	if spec.VariadicParameter != nil {
+		varParam = &function.Parameter{Name: spec.VariadicParameter.Name, Type: spec.VariadicParameter.Type}
+	}
+	sig := functionSignature(name, params, varParam)
+	if spec.Return != cty.NilType {
+		sig += " " + constraintString(spec.Return)
+	}
+	return sig
+}
+
+// functionSignature returns a short description of how to call the named
+// function, such as "join(separator string, lists... list of string)".
+func functionSignature(name string, params []function.Parameter, varParam *function.Parameter) string {
+	args := make([]string, 0, len(params)+1)
+	for _, param := range params {
+		args = append(args, fmt.Sprintf("%s %s", param.Name, constraintString(param.Type)))
+	}
+	if varParam != nil {
+		args = append(args, fmt.Sprintf("%s... %s", varParam.Name, constraintString(varParam.Type)))
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
+
+func constraintString(ty cty.Type) string {
+	if ty == cty.DynamicPseudoType {
+		return "any"
+	}
+	return ty.FriendlyNameForConstraint()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Modified copy of TypeString from go-cty:
 // https://github.com/zclconf/go-cty-debug/blob/master/ctydebug/type_string.go
 //
@@ -8,6 +8,8 @@ package repl
 import (
 	"flag"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 	"testing"
 
@@ -17,8 +19,10 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/initwd"
+	"github.com/opentofu/opentofu/internal/lang"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
+	"github.com/opentofu/opentofu/internal/tfdiags"
 	"github.com/opentofu/opentofu/internal/tofu"
 
 	_ "github.com/opentofu/opentofu/internal/logging"
@@ -366,6 +370,70 @@ type testSessionInput struct {
 	ErrorContains  string
 }
 
+func TestSession_functions(t *testing.T) {
+	session := &Session{
+		Scope: &lang.Scope{},
+		ProviderFunctions: map[string]providers.GetFunctionsResponse{
+			"example": {
+				Functions: map[string]providers.FunctionSpec{
+					"echo": {
+						Summary: "Returns its argument",
+						Parameters: []providers.FunctionParameterSpec{
+							{Name: "input", Type: cty.String},
+						},
+						Return: cty.String,
+					},
+					"sum": {
+						VariadicParameter: &providers.FunctionParameterSpec{Name: "nums", Type: cty.Number},
+						Return:            cty.Number,
+					},
+				},
+			},
+			"broken": {
+				Diagnostics: tfdiags.Diagnostics{}.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Failed to obtain provider schema",
+					"Could not load the schema for provider broken.",
+				)),
+			},
+		},
+	}
+
+	out, exit, diags := session.Handle("functions")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if exit {
+		t.Fatal("unexpected exit")
+	}
+
+	for _, want := range []string{
+		"Built-in functions:\n",
+		"\n  join(separator string, lists... list of string)\n",
+		"\n  tostring(v any)\n",
+		"\nFunctions of provider \"broken\":\n  (unavailable: Failed to obtain provider schema: Could not load the schema for provider broken.)\n",
+		"\nFunctions of provider \"example\":\n" +
+			"  provider::example::echo(input string) string\n" +
+			"      Returns its argument\n" +
+			"  provider::example::sum(nums... number) number",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "core::") {
+		t.Errorf("output includes functions in the core:: namespace\n%s", out)
+	}
+
+	names := session.FunctionNames()
+	if !slices.Contains(names, "provider::example::echo") || !slices.Contains(names, "abs") {
+		t.Errorf("wrong function names %#v", names)
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("function names are not sorted")
+	}
+}
+
 func TestTypeString(t *testing.T) {
 	tests := []struct {
 		Input cty.Value
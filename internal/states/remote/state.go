@@ -225,6 +225,12 @@ func (s *State) PersistState(schemas *tofu.Schemas) error {
 		return err
 	}
 
+	// Some clients can't store a snapshot over a certain size, so we check
+	// the exact bytes we're about to write before trying.
+	if err := statemgr.CheckStateSize(s.Client, buf.Bytes()); err != nil {
+		return err
+	}
+
 	err = s.Client.Put(buf.Bytes())
 	if err != nil {
 		return err
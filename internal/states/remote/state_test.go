@@ -6,7 +6,9 @@
 package remote
 
 import (
+	"errors"
 	"log"
+	"strings"
 	"sync"
 	"testing"
 
@@ -415,6 +417,46 @@ func TestState_GetRootOutputValues(t *testing.T) {
 	}
 }
 
+func TestStatePersist_sizeLimit(t *testing.T) {
+	client := &mockSizeLimitedClient{limit: 100}
+	mgr := NewState(client, encryption.StateEncryptionDisabled())
+
+	s := states.BuildState(func(s *states.SyncState) {
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "large"}.Absolute(addrs.RootModuleInstance),
+			cty.StringVal(strings.Repeat("x", 200)),
+			false,
+		)
+	})
+	if err := mgr.WriteState(s); err != nil {
+		t.Fatal(err)
+	}
+
+	err := mgr.PersistState(nil)
+	var sizeErr *statemgr.StateTooLargeError
+	if !errors.As(err, &sizeErr) {
+		t.Fatalf("expected a StateTooLargeError, got %#v", err)
+	}
+	if sizeErr.Limit != 100 || sizeErr.Size <= 200 {
+		t.Errorf("wrong size or limit in error: %#v", sizeErr)
+	}
+	for _, req := range client.log {
+		if req.Method == "Put" {
+			t.Fatal("state was written despite being over the size limit")
+		}
+	}
+}
+
+// mockSizeLimitedClient is a mockClient that implements statemgr.SizeLimiter.
+type mockSizeLimitedClient struct {
+	mockClient
+	limit int64
+}
+
+func (c *mockSizeLimitedClient) MaxStateSize() int64 {
+	return c.limit
+}
+
 type migrationTestCase struct {
 	name string
 	// A function to generate a statefile
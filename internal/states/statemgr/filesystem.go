@@ -7,6 +7,7 @@ package statemgr
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -57,6 +58,12 @@ type Filesystem struct {
 	// hurt to remove file we never wrote to.
 	created bool
 
+	// syncedDir is set to true once we've synced the directory containing
+	// a state file that we created, so that its directory entry survives a
+	// crash. We only need to do that once, because after that we only ever
+	// rewrite the file in place.
+	syncedDir bool
+
 	mu             sync.Mutex
 	file, readFile *statefile.File
 	backupFile     *statefile.File
@@ -164,15 +171,17 @@ func (s *Filesystem) PersistState(schemas *tofu.Schemas) error {
 }
 
 func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
-	// TODO: this should use a more robust method of writing state, by first
-	// writing to a temp file on the same filesystem, and renaming the file over
-	// the original.
+	// We rewrite the state file in place rather than writing a temporary
+	// file and renaming it over the original, because our lock is held on
+	// the open file itself: a lock on a renamed-away file would not exclude
+	// another process that opens the new file at the same path. Instead, we
+	// make sure the backup is durable before we truncate the original, and
+	// that the new snapshot is durable before we return.
 	if s.stateFileOut == nil {
 		if err := s.createStateFiles(); err != nil {
 			return nil
 		}
 	}
-	defer s.stateFileOut.Sync()
 
 	if s.file == nil {
 		s.file = NewStateFile()
@@ -194,6 +203,12 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 			if err != nil {
 				return fmt.Errorf("failed to write to local state backup file: %w", err)
 			}
+			if err := bfh.Sync(); err != nil {
+				return fmt.Errorf("failed to sync local state backup file to disk: %w", err)
+			}
+			if err := syncDir(filepath.Dir(s.backupPath)); err != nil {
+				return fmt.Errorf("failed to sync the directory of the local state backup file to disk: %w", err)
+			}
 
 			s.writtenBackup = true
 		} else {
@@ -223,7 +238,7 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 	if state == nil {
 		// if we have no state, don't write anything else.
 		log.Print("[TRACE] statemgr.Filesystem: state is nil, so leaving the file empty")
-		return nil
+		return s.syncStateFile()
 	}
 
 	if s.readFile == nil || !statefile.StatesMarshalEqual(s.file.State, s.readFile.State) {
@@ -237,12 +252,35 @@ func (s *Filesystem) persistState(schemas *tofu.Schemas) error {
 	if err := statefile.Write(s.file, s.stateFileOut, s.encryption); err != nil {
 		return err
 	}
+	if err := s.syncStateFile(); err != nil {
+		return err
+	}
 
 	// Any future reads must come from the file we've now updated
 	s.readPath = s.path
 	return nil
 }
 
+// syncStateFile flushes the state file's contents to disk, along with the
+// directory entry of the file if we created it, so that a snapshot we've
+// persisted survives a crash or power loss.
+//
+// This doesn't affect the lock we hold on the file: the lock belongs to the
+// open file, which we keep open, and syncing the directory uses a separate
+// file descriptor for the directory rather than for the state file.
+func (s *Filesystem) syncStateFile() error {
+	if err := s.stateFileOut.Sync(); err != nil {
+		return fmt.Errorf("failed to sync state file to disk: %w", err)
+	}
+	if s.created && !s.syncedDir {
+		if err := syncDir(filepath.Dir(s.path)); err != nil {
+			return fmt.Errorf("failed to sync the directory of the state file to disk: %w", err)
+		}
+		s.syncedDir = true
+	}
+	return nil
+}
+
 // RefreshState is an implementation of Refresher.
 func (s *Filesystem) RefreshState() error {
 	defer s.mutex()()
@@ -326,6 +364,9 @@ func (s *Filesystem) refreshState() error {
 }
 
 // Lock implements Locker using filesystem discretionary locks.
+//
+// Lock fails immediately if another process already holds the lock. Use
+// LockWithContext to wait for a lock held elsewhere to be released.
 func (s *Filesystem) Lock(info *LockInfo) (string, error) {
 	defer s.mutex()()
 
@@ -348,6 +389,9 @@ func (s *Filesystem) Lock(info *LockInfo) (string, error) {
 		lockErr := &LockError{
 			Info: info,
 			Err:  err,
+			// The other process may not have written its lock info yet,
+			// but its lock can still be waited for.
+			Contended: isLockContended(err),
 		}
 
 		return "", lockErr
@@ -357,6 +401,18 @@ func (s *Filesystem) Lock(info *LockInfo) (string, error) {
 	return s.lockID, s.writeLockInfo(info)
 }
 
+// LockWithContext is like Lock, except that if the state file is locked by
+// another process it retries with exponential backoff until the lock is
+// released or the given context is done, rather than failing on the first
+// attempt.
+//
+// This is the same as calling the LockWithContext function with s, which
+// is what "tofu" commands do for -lock-timeout. Other calls to s aren't
+// blocked while it waits.
+func (s *Filesystem) LockWithContext(ctx context.Context, info *LockInfo) (string, error) {
+	return LockWithContext(ctx, s, info)
+}
+
 // Unlock is the companion to Lock, completing the implementation of Locker.
 func (s *Filesystem) Unlock(id string) error {
 	defer s.mutex()()
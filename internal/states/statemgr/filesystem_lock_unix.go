@@ -9,6 +9,7 @@
 package statemgr
 
 import (
+	"errors"
 	"io"
 	"log"
 	"syscall"
@@ -29,6 +30,15 @@ func (s *Filesystem) lock() error {
 	return syscall.FcntlFlock(fd, syscall.F_SETLK, flock)
 }
 
+// isLockContended returns true if the given error from lock indicates that
+// the lock is currently held by another process, in which case the lock
+// attempt may succeed if retried later.
+func isLockContended(err error) bool {
+	// POSIX allows F_SETLK to report a conflicting lock as either EAGAIN
+	// or EACCES.
+	return errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EACCES)
+}
+
 func (s *Filesystem) unlock() error {
 	log.Printf("[TRACE] statemgr.Filesystem: unlocking %s using fcntl flock", s.path)
 	flock := &syscall.Flock_t{
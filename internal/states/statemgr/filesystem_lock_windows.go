@@ -9,6 +9,7 @@
 package statemgr
 
 import (
+	"errors"
 	"log"
 	"math"
 	"syscall"
@@ -26,6 +27,11 @@ const (
 	// https://msdn.microsoft.com/en-us/library/windows/desktop/aa365203(v=vs.85).aspx
 	_LOCKFILE_FAIL_IMMEDIATELY = 1
 	_LOCKFILE_EXCLUSIVE_LOCK   = 2
+
+	// error returned by LockFileEx when _LOCKFILE_FAIL_IMMEDIATELY is set
+	// and the range is already locked by another handle
+	// https://learn.microsoft.com/en-us/windows/win32/debug/system-error-codes--0-499-
+	_ERROR_LOCK_VIOLATION syscall.Errno = 33
 )
 
 func (s *Filesystem) lock() error {
@@ -49,6 +55,13 @@ func (s *Filesystem) lock() error {
 	)
 }
 
+// isLockContended returns true if the given error from lock indicates that
+// the lock is currently held by another process, in which case the lock
+// attempt may succeed if retried later.
+func isLockContended(err error) bool {
+	return errors.Is(err, _ERROR_LOCK_VIOLATION)
+}
+
 func (s *Filesystem) unlock() error {
 	log.Printf("[TRACE] statemgr.Filesystem: unlocked by closing %s", s.path)
 
@@ -0,0 +1,15 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build windows
+// +build windows
+
+package statemgr
+
+// syncDir does nothing on Windows, where a directory can't be opened for
+// writing in order to flush it, and NTFS journals directory changes itself.
+func syncDir(path string) error {
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	version "github.com/hashicorp/go-version"
@@ -108,6 +109,60 @@ func TestFilesystemLocks(t *testing.T) {
 	}
 }
 
+func TestFilesystemLockWithContext(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	s := testFilesystem(t)
+	defer os.Remove(s.readPath)
+
+	// Build the helper up front so that compile time does not count against
+	// the lock timeouts below.
+	locker := filepath.Join(t.TempDir(), "lockstate")
+	if out, err := exec.Command("go", "build", "-o", locker, "testdata/lockstate.go").CombinedOutput(); err != nil {
+		t.Fatal("failed to build lockstate", err, string(out))
+	}
+
+	info := NewLockInfo()
+	info.Operation = "test"
+	lockID, err := s.Lock(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With a short timeout the other process must give up while we are
+	// still holding the lock.
+	out, err := exec.Command(locker, s.path, "100ms").CombinedOutput()
+	if err != nil {
+		t.Fatal("unexpected lock failure", err, string(out))
+	}
+	if !strings.Contains(string(out), "lock failed") {
+		t.Fatal("expected 'lock failed', got", string(out))
+	}
+
+	// With a long timeout the other process must keep retrying until we
+	// release the lock, and then succeed. Removing our lock info first
+	// checks that it waits on the lock itself rather than on the info,
+	// which another process may not have written yet.
+	if err := os.Remove(s.lockInfoPath()); err != nil {
+		t.Fatal(err)
+	}
+	var stderr strings.Builder
+	cmd := exec.Command(locker, s.path, "1m")
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(500 * time.Millisecond)
+	if err := s.Unlock(lockID); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Wait(); err != nil {
+		t.Fatal("unexpected lock failure", err, stderr.String())
+	}
+	if strings.Contains(stderr.String(), "lock failed") {
+		t.Fatal("expected lock to succeed after release, got", stderr.String())
+	}
+}
+
 // Verify that we can write to the state file, as Windows' mandatory locking
 // will prevent writing to a handle different than the one that hold the lock.
 func TestFilesystem_writeWhileLocked(t *testing.T) {
@@ -133,6 +188,56 @@ func TestFilesystem_writeWhileLocked(t *testing.T) {
 	}
 }
 
+func TestFilesystem_persistWhileLocked(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	statePath := filepath.Join(t.TempDir(), "new-dir", "terraform.tfstate")
+	s := NewFilesystem(statePath, encryption.StateEncryptionDisabled())
+
+	info := NewLockInfo()
+	info.Operation = "test"
+	lockID, err := s.Lock(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := s.Unlock(lockID); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	want := TestFullInitialState()
+	if err := s.WriteState(want); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PersistState(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Syncing the file and its directory to disk must not release the lock.
+	out, err := exec.Command("go", "run", "testdata/lockstate.go", statePath).CombinedOutput()
+	if err != nil {
+		t.Fatal("unexpected lock failure", err, string(out))
+	}
+	if !strings.Contains(string(out), "lock failed") {
+		t.Fatal("expected 'lock failed', got", string(out))
+	}
+
+	fh, err := os.Open(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+	f, err := statefile.Read(fh, encryption.StateEncryptionDisabled())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.State.Equal(want) {
+		for _, problem := range deep.Equal(want, f.State) {
+			t.Error(problem)
+		}
+	}
+}
+
 func TestFilesystem_pathOut(t *testing.T) {
 	defer testOverrideVersion(t, "1.2.3")()
 	f, err := os.CreateTemp("", "tf")
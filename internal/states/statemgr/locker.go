@@ -221,6 +221,10 @@ type LockError struct {
 	// Set when writing of lock file fails because of conflict and
 	// then reading fails because file doesn't exist (removed by other process)
 	InconsistentRead bool
+
+	// Set when the lock is known to be held by someone else, even if Info
+	// couldn't be read, so that it's worth waiting for it to be released.
+	Contended bool
 }
 
 func (e *LockError) Error() string {
@@ -243,7 +247,7 @@ func (e *LockError) Retriable() bool {
 		return false
 	}
 
-	return e.InconsistentRead || (e.Info != nil && e.Info.ID != "")
+	return e.InconsistentRead || e.Contended || (e.Info != nil && e.Info.ID != "")
 }
 
 // RetriableWithoutDelay returns true when delaying can be avoided
@@ -0,0 +1,74 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statemgr
+
+import (
+	"fmt"
+)
+
+// SizeLimiter is an optional interface for state storage that cannot store a
+// state snapshot larger than a fixed size, such as an object store with a
+// limit on the size of each object.
+//
+// State managers that write to such storage should call CheckStateSize with
+// the fully serialized snapshot before attempting to write it, so that the
+// user learns about the limit before the write fails partway through.
+type SizeLimiter interface {
+	// MaxStateSize returns the size in bytes of the largest serialized state
+	// snapshot that the storage can accept, or zero if there is no limit.
+	MaxStateSize() int64
+}
+
+// StateTooLargeError is the error returned by CheckStateSize when a state
+// snapshot is larger than its storage can accept.
+type StateTooLargeError struct {
+	// Size is the size in bytes of the serialized snapshot.
+	Size int64
+
+	// Limit is the size in bytes of the largest snapshot the storage accepts.
+	Limit int64
+}
+
+func (e *StateTooLargeError) Error() string {
+	return fmt.Sprintf(
+		"the serialized state snapshot is %s, which is larger than the %s limit of the state storage, so it was not written",
+		formatStateSize(e.Size), formatStateSize(e.Limit),
+	)
+}
+
+// CheckStateSize returns a *StateTooLargeError if the given storage
+// implements SizeLimiter and the given serialized state snapshot is larger
+// than its limit. It returns nil if the snapshot fits, or if the storage has
+// no limit.
+func CheckStateSize(storage any, data []byte) error {
+	limiter, ok := storage.(SizeLimiter)
+	if !ok {
+		return nil
+	}
+	limit := limiter.MaxStateSize()
+	if limit <= 0 {
+		return nil
+	}
+	if size := int64(len(data)); size > limit {
+		return &StateTooLargeError{Size: size, Limit: limit}
+	}
+	return nil
+}
+
+// formatStateSize returns a human-readable representation of the given size
+// in bytes, which always includes the exact number of bytes.
+func formatStateSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d bytes", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB (%d bytes)", float64(size)/float64(div), "KMGTPE"[exp], size)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statemgr
+
+import (
+	"errors"
+	"testing"
+)
+
+type testSizeLimiter int64
+
+func (l testSizeLimiter) MaxStateSize() int64 {
+	return int64(l)
+}
+
+func TestCheckStateSize(t *testing.T) {
+	data := make([]byte, 5*1024*1024+1)
+
+	tests := map[string]struct {
+		storage any
+		wantErr string
+	}{
+		"no limiter": {
+			storage: struct{}{},
+		},
+		"no limit": {
+			storage: testSizeLimiter(0),
+		},
+		"exactly at limit": {
+			storage: testSizeLimiter(len(data)),
+		},
+		"over limit": {
+			storage: testSizeLimiter(5 * 1024 * 1024),
+			wantErr: "the serialized state snapshot is 5.0 MiB (5242881 bytes), which is larger than the 5.0 MiB (5242880 bytes) limit of the state storage, so it was not written",
+		},
+		"small limit": {
+			storage: testSizeLimiter(100),
+			wantErr: "the serialized state snapshot is 5.0 MiB (5242881 bytes), which is larger than the 100 bytes limit of the state storage, so it was not written",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := CheckStateSize(test.storage, data)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("succeeded; want error")
+			}
+			if got := err.Error(); got != test.wantErr {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+			}
+			var sizeErr *StateTooLargeError
+			if !errors.As(err, &sizeErr) || sizeErr.Size != int64(len(data)) {
+				t.Errorf("wrong error type or size: %#v", err)
+			}
+		})
+	}
+}
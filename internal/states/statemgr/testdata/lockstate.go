@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"io"
 	"log"
 	"os"
+	"time"
 
 	"github.com/opentofu/opentofu/internal/encryption"
 	"github.com/opentofu/opentofu/internal/states/statemgr"
@@ -11,9 +13,12 @@ import (
 
 // Attempt to open and lock a tofu state file.
 // Lock failure exits with 0 and writes "lock failed" to stderr.
+//
+// If a duration is given as the second argument then the lock is retried
+// until that timeout elapses.
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal(os.Args[0], "statefile")
+	if len(os.Args) != 2 && len(os.Args) != 3 {
+		log.Fatal(os.Args[0], "statefile [timeout]")
 	}
 
 	s := statemgr.NewFilesystem(os.Args[1], encryption.StateEncryptionDisabled())
@@ -22,7 +27,18 @@ func main() {
 	info.Operation = "test"
 	info.Info = "state locker"
 
-	_, err := s.Lock(info)
+	var err error
+	if len(os.Args) == 3 {
+		timeout, parseErr := time.ParseDuration(os.Args[2])
+		if parseErr != nil {
+			log.Fatal(parseErr)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_, err = s.LockWithContext(ctx, info)
+	} else {
+		_, err = s.Lock(info)
+	}
 	if err != nil {
 		io.WriteString(os.Stderr, "lock failed")
 	}
@@ -39,9 +39,15 @@ const (
 // ContextOpts are the user-configurable options to create a context with
 // NewContext.
 type ContextOpts struct {
-	Meta         *ContextMeta
-	Hooks        []Hook
-	Parallelism  int
+	Meta        *ContextMeta
+	Hooks       []Hook
+	Parallelism int
+
+	// RefreshParallelism is the limit on concurrent operations for walks
+	// that only read from providers, such as a refresh-only plan. If this is
+	// zero then those walks share the same limit as Parallelism.
+	RefreshParallelism int
+
 	Providers    map[addrs.Provider]providers.Factory
 	Provisioners map[string]provisioners.Factory
 	Encryption   encryption.Encryption
@@ -86,6 +92,7 @@ type Context struct {
 	uiInput UIInput
 
 	parallelSem         Semaphore
+	refreshSem          Semaphore
 	l                   sync.Mutex // Lock acquired during any task
 	providerInputConfig map[string]map[string]cty.Value
 	runCond             *sync.Cond
@@ -134,6 +141,23 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		par = 10
 	}
 
+	// Refresh-only walks share the main semaphore unless the caller asked
+	// for a separate limit, so that the default behavior is unchanged.
+	refreshPar := opts.RefreshParallelism
+	if refreshPar < 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid refresh parallelism value",
+			fmt.Sprintf("The refresh parallelism must be a positive value. Not %d.", refreshPar),
+		))
+		return nil, diags
+	}
+	parallelSem := NewSemaphore(par)
+	refreshSem := parallelSem
+	if refreshPar != 0 && refreshPar != par {
+		refreshSem = NewSemaphore(refreshPar)
+	}
+
 	plugins := newContextPlugins(opts.Providers, opts.Provisioners)
 
 	log.Printf("[TRACE] tofu.NewContext: complete")
@@ -145,7 +169,8 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 
 		plugins: plugins,
 
-		parallelSem:         NewSemaphore(par),
+		parallelSem:         parallelSem,
+		refreshSem:          refreshSem,
 		providerInputConfig: make(map[string]map[string]cty.Value),
 		sh:                  sh,
 
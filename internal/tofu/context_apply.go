@@ -120,44 +120,11 @@ Note that the -target option is not suitable for routine use, and is provided on
 }
 
 func (c *Context) applyGraph(plan *plans.Plan, config *configs.Config, validate bool) (*Graph, walkOperation, tfdiags.Diagnostics) {
-	var diags tfdiags.Diagnostics
-
-	variables := InputValues{}
-	for name, dyVal := range plan.VariableValues {
-		val, err := dyVal.Decode(cty.DynamicPseudoType)
-		if err != nil {
-			diags = diags.Append(tfdiags.Sourceless(
-				tfdiags.Error,
-				"Invalid variable value in plan",
-				fmt.Sprintf("Invalid value for variable %q recorded in plan file: %s.", name, err),
-			))
-			continue
-		}
-
-		variables[name] = &InputValue{
-			Value:      val,
-			SourceType: ValueFromPlan,
-		}
-	}
+	variables, diags := planVariableValues(plan, config)
 	if diags.HasErrors() {
 		return nil, walkApply, diags
 	}
 
-	// The plan.VariableValues field only records variables that were actually
-	// set by the caller in the PlanOpts, so we may need to provide
-	// placeholders for any other variables that the user didn't set, in
-	// which case OpenTofu will once again use the default value from the
-	// configuration when we visit these variables during the graph walk.
-	for name := range config.Module.Variables {
-		if _, ok := variables[name]; ok {
-			continue
-		}
-		variables[name] = &InputValue{
-			Value:      cty.NilVal,
-			SourceType: ValueFromPlan,
-		}
-	}
-
 	operation := walkApply
 	if plan.UIMode == plans.DestroyMode {
 		// FIXME: Due to differences in how objects must be handled in the
@@ -200,11 +167,61 @@ func (c *Context) applyGraph(plan *plans.Plan, config *configs.Config, validate
 // Core currently uses as an implementation detail of planning.
 func (c *Context) ApplyGraphForUI(plan *plans.Plan, config *configs.Config) (*Graph, tfdiags.Diagnostics) {
 	// For now though, this really is just the internal graph, confusing
-	// implementation details and all.
+	// implementation details and all, except that each resource instance
+	// node is labelled with the action planned for it.
 
 	var diags tfdiags.Diagnostics
 
 	graph, _, moreDiags := c.applyGraph(plan, config, false)
 	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return nil, diags
+	}
+
+	labelPlannedActions(graph, plan.Changes)
 	return graph, diags
 }
+
+// planVariableValues returns the values of the root module input variables
+// recorded in the given plan.
+func planVariableValues(plan *plans.Plan, config *configs.Config) (InputValues, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	variables := InputValues{}
+	for name, dyVal := range plan.VariableValues {
+		val, err := dyVal.Decode(cty.DynamicPseudoType)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid variable value in plan",
+				fmt.Sprintf("Invalid value for variable %q recorded in plan file: %s.", name, err),
+			))
+			continue
+		}
+
+		variables[name] = &InputValue{
+			Value:      val,
+			SourceType: ValueFromPlan,
+		}
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	// The plan.VariableValues field only records variables that were actually
+	// set by the caller in the PlanOpts, so we may need to provide
+	// placeholders for any other variables that the user didn't set, in
+	// which case OpenTofu will once again use the default value from the
+	// configuration when we visit these variables during the graph walk.
+	for name := range config.Module.Variables {
+		if _, ok := variables[name]; ok {
+			continue
+		}
+		variables[name] = &InputValue{
+			Value:      cty.NilVal,
+			SourceType: ValueFromPlan,
+		}
+	}
+
+	return variables, diags
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"testing"
@@ -2307,3 +2308,98 @@ func TestContext2Apply_forgetOrphanAndDeposed(t *testing.T) {
 		t.Fatalf("PostApply hook should not be called as part of forget")
 	}
 }
+
+func TestContext2Apply_providerForEach(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+provider "test" {
+  for_each    = { east = "e", west = "w" }
+  test_string = "${each.key}-${each.value}"
+}
+
+resource "test_object" "a" {
+  provider    = test["east"]
+  test_string = "a"
+}
+
+resource "test_object" "b" {
+  provider    = test.west
+  test_string = "b"
+}
+
+module "child" {
+  source = "./child"
+  providers = {
+    test = test["west"]
+  }
+}
+`,
+		"child/main.tf": `
+resource "test_object" "c" {
+  test_string = "c"
+}
+`,
+	})
+
+	// Each provider configuration gets its own provider instance, which
+	// records the resources it was asked to plan and apply.
+	var mu sync.Mutex
+	used := make(map[string][]string)
+	factory := func() (providers.Interface, error) {
+		p := simpleMockProvider()
+		var configured string
+		p.ConfigureProviderFn = func(req providers.ConfigureProviderRequest) (resp providers.ConfigureProviderResponse) {
+			configured = req.Config.GetAttr("test_string").AsString()
+			return resp
+		}
+		p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+			if configured == "" {
+				resp.Diagnostics = resp.Diagnostics.Append(errors.New("provider used before it was configured"))
+				return resp
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			used[configured] = append(used[configured], req.Config.GetAttr("test_string").AsString())
+			resp.PlannedState = req.ProposedNewState
+			return resp
+		}
+		return p, nil
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): factory,
+		},
+	})
+
+	plan, diags := ctx.Plan(m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	for _, used := range used {
+		sort.Strings(used)
+	}
+	want := map[string][]string{
+		"east-e": {"a"},
+		"west-w": {"b", "c"},
+	}
+	if diff := cmp.Diff(want, used); diff != "" {
+		t.Fatalf("wrong provider configurations used\n%s", diff)
+	}
+
+	state, diags := ctx.Apply(plan, m)
+	assertNoErrors(t, diags)
+
+	for addr, want := range map[string]string{
+		"test_object.a":              `provider["registry.opentofu.org/hashicorp/test"].east`,
+		"test_object.b":              `provider["registry.opentofu.org/hashicorp/test"].west`,
+		"module.child.test_object.c": `provider["registry.opentofu.org/hashicorp/test"].west`,
+	} {
+		rs := state.Resource(mustAbsResourceAddr(addr))
+		if rs == nil {
+			t.Fatalf("%s not in state", addr)
+		}
+		if got := rs.ProviderConfig.String(); got != want {
+			t.Errorf("wrong provider for %s\ngot:  %s\nwant: %s", addr, got, want)
+		}
+	}
+}
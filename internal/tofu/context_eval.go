@@ -11,12 +11,20 @@ import (
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/lang"
+	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
 
 type EvalOpts struct {
 	SetVariables InputValues
+
+	// Plan, if set, is a plan created from the given configuration and
+	// state. Expressions are then evaluated against the planned values of
+	// its resource instances instead of the values in the state, and
+	// SetVariables is ignored in favor of the variable values recorded in
+	// the plan.
+	Plan *plans.Plan
 }
 
 // Eval produces a scope in which expressions can be evaluated for
@@ -51,6 +59,16 @@ func (c *Context) Eval(config *configs.Config, state *states.State, moduleAddr a
 	var walker *ContextGraphWalker
 
 	variables := opts.SetVariables
+	var changes *plans.Changes
+	if opts.Plan != nil {
+		var moreDiags tfdiags.Diagnostics
+		variables, moreDiags = planVariableValues(opts.Plan, config)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return nil, diags
+		}
+		state, changes = plannedStateForEval(state, opts.Plan.Changes)
+	}
 
 	// By the time we get here, we should have values defined for all of
 	// the root module variables, even if some of them are "unknown". It's the
@@ -78,6 +96,7 @@ func (c *Context) Eval(config *configs.Config, state *states.State, moduleAddr a
 	walkOpts := &graphWalkOpts{
 		InputState: state,
 		Config:     config,
+		Changes:    changes,
 	}
 
 	walker, moreDiags = c.walk(graph, walkEval, walkOpts)
@@ -99,3 +118,49 @@ func (c *Context) Eval(config *configs.Config, state *states.State, moduleAddr a
 	evalCtx := walker.EnterPath(moduleAddr)
 	return evalCtx.EvaluationScope(nil, nil, EvalDataForNoInstanceKey), diags
 }
+
+// plannedStateForEval prepares the given state and planned changes for
+// evaluating expressions against the planned values, in the same way as the
+// plan walk leaves them: every resource instance object with a planned
+// change other than a deletion has the special "planned" status in the
+// state, which makes the evaluator use the planned value from the changes
+// instead.
+//
+// The given state must already be a copy that can be modified. The returned
+// changes are a copy of the given ones, because the evaluation walk may
+// modify them.
+func plannedStateForEval(state *states.State, changes *plans.Changes) (*states.State, *plans.Changes) {
+	ret := &plans.Changes{
+		Resources: append([]*plans.ResourceInstanceChangeSrc(nil), changes.Resources...),
+		Outputs:   append([]*plans.OutputChangeSrc(nil), changes.Outputs...),
+	}
+
+	for _, change := range changes.Resources {
+		if change.DeposedKey != states.NotDeposed {
+			continue
+		}
+		switch change.Action {
+		case plans.Delete, plans.Forget:
+			// The evaluator already skips objects that are being deleted.
+			continue
+		}
+
+		var prior *states.ResourceInstanceObjectSrc
+		if rs := state.Resource(change.Addr.ContainingResource()); rs != nil {
+			if is := rs.Instance(change.Addr.Resource.Key); is != nil {
+				prior = is.Current
+			}
+		}
+		obj := &states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectPlanned,
+			AttrsJSON: []byte("{}"),
+		}
+		if prior != nil {
+			obj.AttrsJSON = prior.AttrsJSON
+			obj.SchemaVersion = prior.SchemaVersion
+		}
+		state.EnsureModule(change.Addr.Module).SetResourceInstanceCurrent(change.Addr.Resource, obj, change.ProviderAddr)
+	}
+
+	return state, ret
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs/configschema"
 	"github.com/opentofu/opentofu/internal/providers"
 	"github.com/opentofu/opentofu/internal/states"
 	"github.com/zclconf/go-cty/cty"
@@ -133,3 +134,95 @@ output "out" {
 	})
 	assertNoErrors(t, diags)
 }
+
+func TestContextEval_plan(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "existing" {
+  value = "new"
+}
+
+resource "test_object" "created" {
+  value = test_object.existing.value
+}
+
+module "mod" {
+  source = "./mod"
+  input  = test_object.created.id
+}
+`,
+
+		"./mod/main.tf": `
+variable "input" {
+  type = string
+}
+
+output "out" {
+  value = var.input
+}
+`,
+	})
+
+	p := testProvider("test")
+	p.GetProviderSchemaResponse = getProviderSchemaResponseFromProviderSchema(&ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_object": {
+				Attributes: map[string]*configschema.Attribute{
+					"id":    {Type: cty.String, Computed: true},
+					"value": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr("test_object.existing"),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"existing","value":"old"}`),
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+		)
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	plan, diags := ctx.Plan(m, state, DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	scope, diags := ctx.Eval(m, plan.PriorState, addrs.RootModuleInstance, &EvalOpts{
+		Plan: plan,
+	})
+	assertNoErrors(t, diags)
+
+	tests := map[string]cty.Value{
+		`test_object.existing.id`:    cty.StringVal("existing"),
+		`test_object.existing.value`: cty.StringVal("new"),
+		`test_object.created.id`:     cty.UnknownVal(cty.String),
+		`test_object.created.value`:  cty.StringVal("new"),
+		`module.mod.out`:             cty.UnknownVal(cty.String),
+	}
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			expr, _ := hclsyntax.ParseExpression([]byte(input), "<test-input>", hcl.Pos{Line: 1, Column: 1})
+			got, diags := scope.EvalExpr(expr, cty.DynamicPseudoType)
+			assertNoErrors(t, diags)
+			if !got.RawEquals(want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+			}
+		})
+	}
+
+	// Evaluation must not modify the plan.
+	if len(plan.Changes.Resources) != 2 {
+		t.Errorf("plan has %d resource changes after evaluation; want 2", len(plan.Changes.Resources))
+	}
+	if rs := plan.PriorState.ResourceInstance(mustResourceInstanceAddr("test_object.existing")); rs.Current.Status != states.ObjectReady {
+		t.Errorf("prior state was modified")
+	}
+}
@@ -183,3 +183,34 @@ func providerFunctionParameter(spec providers.FunctionParameterSpec) function.Pa
 		AllowMarked: false,
 	}
 }
+
+// ProviderFunctions returns the functions that each of the providers required
+// by the given module offer in their schemas, keyed by the local name that the
+// module uses for the provider in provider::NAME::FUNCTION calls.
+//
+// This is intended for describing the available functions in the UI. Any
+// problem starting a provider or obtaining its schema is returned in the
+// Diagnostics of that provider's entry, so that the functions of the other
+// providers can still be described.
+func (c *Context) ProviderFunctions(config *configs.Config) map[string]providers.GetFunctionsResponse {
+	ret := make(map[string]providers.GetFunctionsResponse)
+	if config == nil || config.Module.ProviderRequirements == nil {
+		return ret
+	}
+
+	for name, req := range config.Module.ProviderRequirements.RequiredProviders {
+		var resp providers.GetFunctionsResponse
+		schema, err := c.plugins.ProviderSchema(req.Type)
+		if err != nil {
+			resp.Diagnostics = resp.Diagnostics.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to obtain provider schema",
+				fmt.Sprintf("Could not load the schema for provider %s: %s.", req.Type, err),
+			))
+		} else {
+			resp.Functions = schema.Functions
+		}
+		ret[name] = resp
+	}
+	return ret
+}
@@ -26,6 +26,13 @@ type ImportOpts struct {
 	// SetVariables are the variables set outside of the configuration,
 	// such as on the command line, in variables files, etc.
 	SetVariables InputValues
+
+	// KeepPartialState makes Import return the state including every target
+	// that was imported successfully even if importing some of the other
+	// targets failed, rather than returning the previous run state. This is
+	// for importing a batch of independent targets in a single walk, where
+	// the caller reports the targets that failed separately.
+	KeepPartialState bool
 }
 
 // CommandLineImportTarget is a target that we need to import, that originated from the CLI command
@@ -274,7 +281,7 @@ func (c *Context) Import(config *configs.Config, prevRunState *states.State, opt
 		InputState: state,
 	})
 	diags = diags.Append(walkDiags)
-	if walkDiags.HasErrors() {
+	if walkDiags.HasErrors() && !opts.KeepPartialState {
 		return state, diags
 	}
 
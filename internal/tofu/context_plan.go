@@ -78,6 +78,12 @@ type PlanOpts struct {
 	// fully-functional new object.
 	ForceReplace []addrs.AbsResourceInstance
 
+	// ForceReplaceModules is a set of module instances whose managed resource
+	// instances, including those of any nested module calls, are all to be
+	// treated as if they were included in ForceReplace. A module address
+	// without an instance key matches all of the instances of that module.
+	ForceReplaceModules []addrs.ModuleInstance
+
 	// ExternalReferences allows the external caller to pass in references to
 	// nodes that should not be pruned even if they are not referenced within
 	// the actual graph.
@@ -165,7 +171,7 @@ func (c *Context) Plan(config *configs.Config, prevRunState *states.State, opts
 		))
 		return nil, diags
 	}
-	if len(opts.ForceReplace) > 0 && opts.Mode != plans.NormalMode {
+	if (len(opts.ForceReplace) > 0 || len(opts.ForceReplaceModules) > 0) && opts.Mode != plans.NormalMode {
 		// The other modes don't generate no-op or update actions that we might
 		// upgrade to be "replace", so doesn't make sense to combine those.
 		diags = diags.Append(tfdiags.Sourceless(
@@ -328,10 +334,37 @@ func (c *Context) plan(config *configs.Config, prevRunState *states.State, opts
 
 	plan, walkDiags := c.planWalk(config, prevRunState, opts)
 	diags = diags.Append(walkDiags)
+	if plan != nil && !walkDiags.HasErrors() {
+		diags = diags.Append(checkForceReplaceModules(plan.Changes, opts.ForceReplaceModules))
+	}
 
 	return plan, diags
 }
 
+// checkForceReplaceModules returns a warning for each of the given module
+// instances that contains no managed resource instances in the plan, since
+// a request to replace everything in it was then probably a mistake.
+func checkForceReplaceModules(changes *plans.Changes, modules []addrs.ModuleInstance) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	for _, module := range modules {
+		matched := false
+		for _, rc := range changes.Resources {
+			if rc.Addr.Resource.Resource.Mode == addrs.ManagedResourceMode && module.TargetContains(rc.Addr) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"No resource instances to replace",
+				fmt.Sprintf("Your force-replace request for %s doesn't match any resource instances, because that module doesn't have any managed resource instances.", module),
+			))
+		}
+	}
+	return diags
+}
+
 func (c *Context) refreshOnlyPlan(config *configs.Config, prevRunState *states.State, opts *PlanOpts) (*plans.Plan, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
@@ -691,6 +724,7 @@ func (c *Context) planWalk(config *configs.Config, prevRunState *states.State, o
 		Changes:           changes,
 		MoveResults:       moveResults,
 		PlanTimeTimestamp: timestamp,
+		RefreshOnly:       opts.Mode == plans.RefreshOnlyMode,
 	})
 	diags = diags.Append(walker.NonFatalDiagnostics)
 	diags = diags.Append(walkDiags)
@@ -758,19 +792,20 @@ func (c *Context) planGraph(config *configs.Config, prevRunState *states.State,
 	switch mode := opts.Mode; mode {
 	case plans.NormalMode:
 		graph, diags := (&PlanGraphBuilder{
-			Config:             config,
-			State:              prevRunState,
-			RootVariableValues: opts.SetVariables,
-			Plugins:            c.plugins,
-			Targets:            opts.Targets,
-			ForceReplace:       opts.ForceReplace,
-			skipRefresh:        opts.SkipRefresh,
-			preDestroyRefresh:  opts.PreDestroyRefresh,
-			Operation:          walkPlan,
-			ExternalReferences: opts.ExternalReferences,
-			ImportTargets:      opts.ImportTargets,
-			GenerateConfigPath: opts.GenerateConfigPath,
-			EndpointsToRemove:  opts.EndpointsToRemove,
+			Config:              config,
+			State:               prevRunState,
+			RootVariableValues:  opts.SetVariables,
+			Plugins:             c.plugins,
+			Targets:             opts.Targets,
+			ForceReplace:        opts.ForceReplace,
+			ForceReplaceModules: opts.ForceReplaceModules,
+			skipRefresh:         opts.SkipRefresh,
+			preDestroyRefresh:   opts.PreDestroyRefresh,
+			Operation:           walkPlan,
+			ExternalReferences:  opts.ExternalReferences,
+			ImportTargets:       opts.ImportTargets,
+			GenerateConfigPath:  opts.GenerateConfigPath,
+			EndpointsToRemove:   opts.EndpointsToRemove,
 		}).Build(addrs.RootModuleInstance)
 		return graph, walkPlan, diags
 	case plans.RefreshOnlyMode:
@@ -24,6 +24,7 @@ import (
 
 	// "github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/lang/evalchecks"
 	"github.com/opentofu/opentofu/internal/lang/marks"
 	"github.com/opentofu/opentofu/internal/plans"
 	"github.com/opentofu/opentofu/internal/providers"
@@ -1038,6 +1039,121 @@ resource "test_object" "a" {
 	}
 }
 
+func TestContext2Plan_destroyStalePostcondition(t *testing.T) {
+	// The postcondition of test_object.a refers to an attribute that the
+	// provider no longer returns, because the remote object is already
+	// partially gone. Destroying it should not be blocked by the
+	// postcondition, but its precondition must still run.
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "required_tags" {
+  type    = list(string)
+  default = ["env"]
+}
+
+resource "test_object" "a" {
+  lifecycle {
+    precondition {
+      condition     = length(var.required_tags) > 0
+      error_message = "At least one tag must be required."
+    }
+    postcondition {
+      condition     = length(self.tags) > 0
+      error_message = "Must have tags."
+    }
+  }
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	p.GetProviderSchemaResponse = &providers.GetProviderSchemaResponse{
+		Provider: providers.Schema{Block: simpleTestSchema()},
+		ResourceTypes: map[string]providers.Schema{
+			"test_object": {
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"tags": {Type: cty.Map(cty.String), Computed: true},
+					},
+				},
+			},
+		},
+	}
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) (resp providers.ReadResourceResponse) {
+		resp.NewState = cty.ObjectVal(map[string]cty.Value{
+			"tags": cty.NullVal(cty.Map(cty.String)),
+		})
+		return resp
+	}
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+		resp.PlannedState = req.ProposedNewState
+		return resp
+	}
+
+	addr := mustResourceInstanceAddr("test_object.a")
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(addr, &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"tags":{"env":"test"}}`),
+			Status:    states.ObjectReady,
+		}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`))
+	})
+
+	t.Run("stale postcondition", func(t *testing.T) {
+		ctx := testContext2(t, &ContextOpts{
+			Providers: map[addrs.Provider]providers.Factory{
+				addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+			},
+		})
+
+		plan, diags := ctx.Plan(m, state, &PlanOpts{
+			Mode: plans.DestroyMode,
+			SetVariables: InputValues{
+				"required_tags": &InputValue{
+					Value:      cty.ListVal([]cty.Value{cty.StringVal("env")}),
+					SourceType: ValueFromCLIArg,
+				},
+			},
+		})
+		assertNoErrors(t, diags)
+
+		for _, c := range plan.Changes.Resources {
+			if c.Action != plans.Delete {
+				t.Errorf("unexpected %s change for %s", c.Action, c.Addr)
+			}
+		}
+
+		newState, diags := ctx.Apply(plan, m)
+		assertNoErrors(t, diags)
+		if !newState.Empty() {
+			t.Fatalf("expected empty state after destroy, got:\n%s", newState)
+		}
+	})
+
+	t.Run("invalid precondition", func(t *testing.T) {
+		ctx := testContext2(t, &ContextOpts{
+			Providers: map[addrs.Provider]providers.Factory{
+				addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+			},
+		})
+
+		_, diags := ctx.Plan(m, state, &PlanOpts{
+			Mode: plans.DestroyMode,
+			SetVariables: InputValues{
+				"required_tags": &InputValue{
+					Value:      cty.NullVal(cty.List(cty.String)),
+					SourceType: ValueFromCLIArg,
+				},
+			},
+		})
+		if !diags.HasErrors() {
+			t.Fatal("succeeded; want an error from the precondition")
+		}
+		if got, want := diags.Err().Error(), "Invalid function argument"; !strings.Contains(got, want) {
+			t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+		}
+	})
+}
+
 func TestContext2Plan_destroySkipRefresh(t *testing.T) {
 	m := testModuleInline(t, map[string]string{
 		"main.tf": `
@@ -2536,6 +2652,135 @@ func TestContext2Plan_forceReplaceIncompleteAddr(t *testing.T) {
 	})
 }
 
+func TestContext2Plan_forceReplaceModule(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+			resource "test_object" "root" {
+			}
+			module "foo" {
+				source   = "./child"
+				for_each = toset(["a", "b"])
+			}
+		`,
+		"child/main.tf": `
+			resource "test_object" "x" {
+			}
+			data "test_object" "d" {
+			}
+			module "inner" {
+				source = "./inner"
+			}
+		`,
+		"child/inner/main.tf": `
+			resource "test_object" "y" {
+			}
+		`,
+	})
+
+	instances := []string{
+		`test_object.root`,
+		`module.foo["a"].test_object.x`,
+		`module.foo["a"].module.inner.test_object.y`,
+		`module.foo["b"].test_object.x`,
+		`module.foo["b"].module.inner.test_object.y`,
+	}
+	state := states.BuildState(func(s *states.SyncState) {
+		for _, addr := range instances {
+			s.SetResourceInstanceCurrent(mustResourceInstanceAddr(addr), &states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{}`),
+				Status:    states.ObjectReady,
+			}, mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`))
+		}
+	})
+
+	p := simpleMockProvider()
+	p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+		return providers.ReadDataSourceResponse{State: req.Config}
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	plan, diags := ctx.Plan(m, state, &PlanOpts{
+		Mode: plans.NormalMode,
+		ForceReplaceModules: []addrs.ModuleInstance{
+			mustModuleInstance(`module.foo["a"]`),
+		},
+	})
+	assertNoDiagnostics(t, diags)
+
+	want := map[string]plans.Action{
+		`test_object.root`:                           plans.NoOp,
+		`module.foo["a"].test_object.x`:              plans.DeleteThenCreate,
+		`module.foo["a"].module.inner.test_object.y`: plans.DeleteThenCreate,
+		`module.foo["b"].test_object.x`:              plans.NoOp,
+		`module.foo["b"].module.inner.test_object.y`: plans.NoOp,
+	}
+	for addr, wantAction := range want {
+		instPlan := plan.Changes.ResourceInstance(mustResourceInstanceAddr(addr))
+		if instPlan == nil {
+			t.Errorf("no plan for %s at all", addr)
+			continue
+		}
+		if got := instPlan.Action; got != wantAction {
+			t.Errorf("wrong planned action for %s\ngot:  %s\nwant: %s", addr, got, wantAction)
+		}
+		if wantAction.IsReplace() && instPlan.ActionReason != plans.ResourceInstanceReplaceByRequest {
+			t.Errorf("wrong action reason for %s: %s", addr, instPlan.ActionReason)
+		}
+	}
+	for _, rc := range plan.Changes.Resources {
+		if rc.Addr.Resource.Resource.Mode == addrs.DataResourceMode && rc.Action != plans.Read {
+			t.Errorf("wrong planned action for %s: %s", rc.Addr, rc.Action)
+		}
+	}
+}
+
+func TestContext2Plan_forceReplaceModuleNoResources(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+			resource "test_object" "a" {
+			}
+			module "empty" {
+				source = "./empty"
+			}
+		`,
+		"empty/main.tf": `
+			data "test_object" "d" {
+			}
+		`,
+	})
+
+	p := simpleMockProvider()
+	p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+		return providers.ReadDataSourceResponse{State: req.Config}
+	}
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	_, diags := ctx.Plan(m, states.NewState(), &PlanOpts{
+		Mode: plans.NormalMode,
+		ForceReplaceModules: []addrs.ModuleInstance{
+			mustModuleInstance("module.empty"),
+		},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors\n%s", diags.Err().Error())
+	}
+	diagsErr := diags.ErrWithWarnings()
+	if diagsErr == nil {
+		t.Fatalf("no warnings were returned")
+	}
+	if got, want := diagsErr.Error(), "No resource instances to replace"; !strings.Contains(got, want) {
+		t.Errorf("missing expected warning\ngot:\n%s\n\nwant substring: %s", got, want)
+	}
+}
+
 // Verify that adding a module instance does force existing module data sources
 // to be deferred
 func TestContext2Plan_noChangeDataSourceAddingModuleInstance(t *testing.T) {
@@ -3696,6 +3941,162 @@ resource "test_object" "b" {
 	}
 }
 
+func TestContext2Plan_readTriggeredBy(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  test_string = var.value
+}
+
+variable "value" {
+  type = string
+}
+
+data "test_object" "b" {
+  test_string = "static"
+  lifecycle {
+    # a change to the unrelated resource should defer the read until apply
+    read_triggered_by = [ test_object.a.test_string ]
+  }
+}
+`,
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr("test_object.a"),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"test_string":"old"}`),
+				Status:    states.ObjectReady,
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+		)
+	})
+
+	tests := map[string]struct {
+		value    string
+		wantRead bool
+	}{
+		"changed":   {"new", true},
+		"unchanged": {"old", false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := simpleMockProvider()
+			p.ReadDataSourceFn = func(req providers.ReadDataSourceRequest) (resp providers.ReadDataSourceResponse) {
+				resp.State = req.Config
+				return resp
+			}
+			ctx := testContext2(t, &ContextOpts{
+				Providers: map[addrs.Provider]providers.Factory{
+					addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+				},
+			})
+
+			plan, diags := ctx.Plan(m, state, &PlanOpts{
+				Mode: plans.NormalMode,
+				SetVariables: InputValues{
+					"value": &InputValue{
+						Value:      cty.StringVal(test.value),
+						SourceType: ValueFromCaller,
+					},
+				},
+			})
+			assertNoErrors(t, diags)
+
+			rc := plan.Changes.ResourceInstance(mustResourceInstanceAddr("data.test_object.b"))
+			if !test.wantRead {
+				if rc != nil {
+					t.Fatalf("unexpected %s change for data.test_object.b", rc.Action)
+				}
+				if !p.ReadDataSourceCalled {
+					t.Fatal("data source was not read during plan")
+				}
+				return
+			}
+
+			if rc == nil {
+				t.Fatal("no change for data.test_object.b")
+			}
+			if got, want := rc.Action, plans.Read; got != want {
+				t.Errorf("wrong action %s; want %s", got, want)
+			}
+			if got, want := rc.ActionReason, plans.ResourceInstanceReadBecauseDependencyPending; got != want {
+				t.Errorf("wrong action reason %s; want %s", got, want)
+			}
+			if p.ReadDataSourceCalled {
+				t.Error("data source was read during plan")
+			}
+		})
+	}
+}
+
+func TestContext2Plan_forEachHashedKeys(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+locals {
+  # The order of the list must not affect the instance keys.
+  items = [{ name = "b" }, { name = "a" }]
+}
+
+resource "test_object" "a" {
+  for_each    = toset(local.items)
+  test_string = each.value.name
+
+  lifecycle {
+    for_each_keys = "hash"
+  }
+}
+`,
+	})
+
+	keyA, err := evalchecks.HashedForEachKey(cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("a")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyB, err := evalchecks.HashedForEachKey(cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("b")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr(fmt.Sprintf("test_object.a[%q]", keyA)),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"test_string":"a"}`),
+				Status:    states.ObjectReady,
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+		)
+	})
+
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	// Sets of objects are only valid with hashed keys.
+	assertNoErrors(t, ctx.Validate(m))
+
+	plan, diags := ctx.Plan(m, state, DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	got := map[string]plans.Action{}
+	for _, c := range plan.Changes.Resources {
+		got[c.Addr.String()] = c.Action
+	}
+	want := map[string]plans.Action{
+		fmt.Sprintf("test_object.a[%q]", keyA): plans.NoOp,
+		fmt.Sprintf("test_object.a[%q]", keyB): plans.Create,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong planned actions\n%s", diff)
+	}
+}
+
 func TestContext2Plan_dataSchemaChange(t *testing.T) {
 	// We can't decode the prior state when a data source upgrades the schema
 	// in an incompatible way. Since prior state for data sources is purely
@@ -7715,3 +8116,75 @@ func featuresBlockTestSchema() *configschema.Block {
 		},
 	}
 }
+
+func TestContext2Plan_createBeforeDestroyInferredWarning(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  test_string = "a"
+}
+
+resource "test_object" "b" {
+  test_string = test_object.a.test_string
+}
+
+resource "test_object" "c" {
+  test_string = test_object.b.test_string
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`,
+	})
+
+	p := simpleMockProvider()
+
+	state := states.NewState()
+	root := state.EnsureModule(addrs.RootModuleInstance)
+	for _, name := range []string{"a", "b", "c"} {
+		root.SetResourceInstanceCurrent(
+			mustResourceInstanceAddr("test_object."+name).Resource,
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"test_string":"a"}`),
+			},
+			mustProviderConfig(`provider["registry.opentofu.org/hashicorp/test"]`),
+		)
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	plan, diags := ctx.Plan(m, state, &PlanOpts{
+		Mode: plans.NormalMode,
+		ForceReplace: []addrs.AbsResourceInstance{
+			mustResourceInstanceAddr("test_object.a"),
+		},
+	})
+	assertNoErrors(t, diags)
+
+	change := plan.Changes.ResourceInstance(mustResourceInstanceAddr("test_object.a"))
+	if change == nil || change.Action != plans.CreateThenDelete {
+		t.Fatalf("wrong change for test_object.a: %#v", change)
+	}
+
+	var warnings []string
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning {
+			desc := diag.Description()
+			warnings = append(warnings, desc.Summary+": "+desc.Detail)
+		}
+	}
+	// Only test_object.a is replaced, so only it is reported, and the warning
+	// names test_object.c even though b is the resource that depends on a
+	// directly.
+	want := []string{
+		"Create before destroy inferred from a dependent resource: test_object.a must be replaced. Its configuration doesn't set create_before_destroy, but OpenTofu will create the replacement before destroying the existing object because test_object.c depends on it and has create_before_destroy set.\n\nTo make this explicit and silence this warning, set create_before_destroy = true in the lifecycle block of this resource.",
+	}
+	if diff := cmp.Diff(want, warnings); diff != "" {
+		t.Errorf("wrong warnings\n%s", diff)
+	}
+}
@@ -22,12 +22,17 @@ import (
 type contextPlugins struct {
 	providerFactories    map[addrs.Provider]providers.Factory
 	provisionerFactories map[string]provisioners.Factory
+
+	// providerTracer, if set, records the duration of each call to the
+	// provider instances returned by NewProviderInstance.
+	providerTracer *providerTracer
 }
 
 func newContextPlugins(providerFactories map[addrs.Provider]providers.Factory, provisionerFactories map[string]provisioners.Factory) *contextPlugins {
 	return &contextPlugins{
 		providerFactories:    providerFactories,
 		provisionerFactories: provisionerFactories,
+		providerTracer:       providerTracerFromEnv(),
 	}
 }
 
@@ -42,8 +47,11 @@ func (cp *contextPlugins) NewProviderInstance(addr addrs.Provider) (providers.In
 		return nil, fmt.Errorf("unavailable provider %q", addr.String())
 	}
 
-	return f()
-
+	p, err := f()
+	if err != nil || cp.providerTracer == nil {
+		return p, err
+	}
+	return newTracedProvider(p, addr, cp.providerTracer), nil
 }
 
 func (cp *contextPlugins) HasProvisioner(typ string) bool {
@@ -173,6 +173,64 @@ terraform {}
 	}
 }
 
+func TestNewContextRefreshParallelism(t *testing.T) {
+	cases := map[string]struct {
+		Parallelism        int
+		RefreshParallelism int
+		WantParallel       int
+		WantRefresh        int
+		WantShared         bool
+	}{
+		"defaults": {
+			WantParallel: 10,
+			WantRefresh:  10,
+			WantShared:   true,
+		},
+		"same as parallelism": {
+			Parallelism:        4,
+			RefreshParallelism: 4,
+			WantParallel:       4,
+			WantRefresh:        4,
+			WantShared:         true,
+		},
+		"separate limit": {
+			Parallelism:        4,
+			RefreshParallelism: 32,
+			WantParallel:       4,
+			WantRefresh:        32,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, diags := NewContext(&ContextOpts{
+				Parallelism:        tc.Parallelism,
+				RefreshParallelism: tc.RefreshParallelism,
+			})
+			if diags.HasErrors() {
+				t.Fatalf("unexpected NewContext errors: %s", diags.Err())
+			}
+			if got := cap(c.parallelSem); got != tc.WantParallel {
+				t.Errorf("wrong parallelism %d; want %d", got, tc.WantParallel)
+			}
+			if got := cap(c.refreshSem); got != tc.WantRefresh {
+				t.Errorf("wrong refresh parallelism %d; want %d", got, tc.WantRefresh)
+			}
+			if shared := c.parallelSem == c.refreshSem; shared != tc.WantShared {
+				t.Errorf("semaphores shared is %t; want %t", shared, tc.WantShared)
+			}
+		})
+	}
+
+	_, diags := NewContext(&ContextOpts{RefreshParallelism: -1})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded with negative refresh parallelism; want error")
+	}
+	if got, want := diags.Err().Error(), "Invalid refresh parallelism value"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
 func TestContext_missingPlugins(t *testing.T) {
 	ctx, diags := NewContext(&ContextOpts{})
 	assertNoDiagnostics(t, diags)
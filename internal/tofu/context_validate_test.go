@@ -1403,6 +1403,48 @@ resource "test_instance" "bar" {
 	}
 }
 
+func TestContext2Validate_outputDependsOnOutput(t *testing.T) {
+	m := testModule(t, "graph-builder-plan-output-depends-on")
+
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	diags := ctx.Validate(m)
+	assertNoErrors(t, diags)
+}
+
+func TestContext2Validate_invalidDependsOnOutputRef(t *testing.T) {
+	// This test is verifying that we raise an error if an output's
+	// depends_on refers to an output value that doesn't exist.
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+output "foo" {
+  value      = "foo"
+  depends_on = [output.nonexistent]
+}
+`,
+	})
+
+	p := testProvider("test")
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+
+	diags := ctx.Validate(m)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+	if got, want := diags.Err().Error(), "Reference to undeclared output value:"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error:\ngot:  %s\nwant: message containing %q", got, want)
+	}
+}
+
 func TestContext2Validate_invalidResourceIgnoreChanges(t *testing.T) {
 	// This test is verifying that we raise an error if ignore_changes
 	// refers to something that can be statically detected as not conforming
@@ -44,6 +44,11 @@ type graphWalkOpts struct {
 	PlanTimeTimestamp time.Time
 
 	MoveResults refactoring.MoveResults
+
+	// RefreshOnly should be set for walks that only read from providers and
+	// never change remote objects, which then run with the separate limit
+	// given in ContextOpts.RefreshParallelism.
+	RefreshOnly bool
 }
 
 func (c *Context) walk(graph *Graph, operation walkOperation, opts *graphWalkOpts) (*ContextGraphWalker, tfdiags.Diagnostics) {
@@ -139,6 +144,13 @@ func (c *Context) graphWalker(operation walkOperation, opts *graphWalkOpts) *Con
 		}
 	}
 
+	// The refresh limit applies to every provider alike, since providers
+	// have no way to tell us they can't handle more concurrent requests.
+	sem := c.parallelSem
+	if opts.RefreshOnly {
+		sem = c.refreshSem
+	}
+
 	return &ContextGraphWalker{
 		Context:          c,
 		State:            state,
@@ -154,5 +166,6 @@ func (c *Context) graphWalker(operation walkOperation, opts *graphWalkOpts) *Con
 		StopContext:      c.runContext,
 		PlanTimestamp:    opts.PlanTimeTimestamp,
 		Encryption:       c.encryption,
+		parallelSem:      sem,
 	}
 }
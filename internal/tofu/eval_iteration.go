@@ -10,6 +10,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/lang/evalchecks"
 	"github.com/opentofu/opentofu/internal/tfdiags"
 )
@@ -36,10 +37,24 @@ func evaluateForEachExpression(expr hcl.Expression, ctx EvalContext) (map[string
 	return evalchecks.EvaluateForEachExpression(expr, evalContextScope(ctx))
 }
 
+// evaluateResourceForEachExpression is like evaluateForEachExpression, but
+// for the for_each argument of the given resource, taking into account
+// whether the resource uses hashed instance keys.
+func evaluateResourceForEachExpression(config *configs.Resource, ctx EvalContext) (map[string]cty.Value, tfdiags.Diagnostics) {
+	if config.ForEachKeysHashed {
+		return evalchecks.EvaluateHashedForEachExpression(config.ForEach, evalContextScope(ctx))
+	}
+	return evaluateForEachExpression(config.ForEach, ctx)
+}
+
 func evaluateForEachExpressionValue(expr hcl.Expression, ctx EvalContext, allowUnknown bool, allowTuple bool) (cty.Value, tfdiags.Diagnostics) {
 	return evalchecks.EvaluateForEachExpressionValue(expr, evalContextScope(ctx), allowUnknown, allowTuple)
 }
 
+func evaluateHashedForEachExpressionValue(expr hcl.Expression, ctx EvalContext, allowUnknown bool) (cty.Value, tfdiags.Diagnostics) {
+	return evalchecks.EvaluateHashedForEachExpressionValue(expr, evalContextScope(ctx), allowUnknown)
+}
+
 func evaluateCountExpression(expr hcl.Expression, ctx EvalContext) (int, tfdiags.Diagnostics) {
 	return evalchecks.EvaluateCountExpression(expr, evalContextEvaluate(ctx))
 }
@@ -51,6 +51,10 @@ type PlanGraphBuilder struct {
 	// action instead. Create and Delete actions are not affected.
 	ForceReplace []addrs.AbsResourceInstance
 
+	// ForceReplaceModules are module instances whose managed resource
+	// instances are all treated as if they were in ForceReplace.
+	ForceReplaceModules []addrs.ModuleInstance
+
 	// skipRefresh indicates that we should skip refreshing managed resources
 	skipRefresh bool
 
@@ -270,6 +274,7 @@ func (b *PlanGraphBuilder) initPlan() {
 			skipPlanChanges:      b.skipPlanChanges,
 			preDestroyRefresh:    b.preDestroyRefresh,
 			forceReplace:         b.ForceReplace,
+			forceReplaceModules:  b.ForceReplaceModules,
 		}
 	}
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs/configschema"
+	"github.com/opentofu/opentofu/internal/dag"
 	"github.com/opentofu/opentofu/internal/providers"
 )
 
@@ -60,6 +61,36 @@ func TestPlanGraphBuilder(t *testing.T) {
 	}
 }
 
+func TestPlanGraphBuilder_outputDependsOnOutput(t *testing.T) {
+	plugins := newContextPlugins(map[addrs.Provider]providers.Factory{
+		addrs.NewDefaultProvider("test"): providers.FactoryFixed(simpleMockProvider()),
+	}, nil)
+
+	b := &PlanGraphBuilder{
+		Config:    testModule(t, "graph-builder-plan-output-depends-on"),
+		Plugins:   plugins,
+		Operation: walkPlan,
+	}
+
+	g, err := b.Build(addrs.RootModuleInstance)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	vertices := make(map[string]dag.Vertex)
+	for _, v := range g.Vertices() {
+		vertices[dag.VertexName(v)] = v
+	}
+	for from, to := range map[string]string{
+		"output.summary (expand)":             "output.first (expand)",
+		"module.child.output.second (expand)": "module.child.output.first (expand)",
+	} {
+		if !g.HasEdge(dag.BasicEdge(vertices[from], vertices[to])) {
+			t.Errorf("missing edge from %s to %s in graph:\n%s", from, to, g.String())
+		}
+	}
+}
+
 func TestPlanGraphBuilder_dynamicBlock(t *testing.T) {
 	provider := mockProviderWithResourceTypeSchema("test_thing", &configschema.Block{
 		Attributes: map[string]*configschema.Attribute{
@@ -5,10 +5,81 @@
 
 package tofu
 
-import "github.com/opentofu/opentofu/internal/dag"
+import (
+	"fmt"
+
+	"github.com/opentofu/opentofu/internal/dag"
+	"github.com/opentofu/opentofu/internal/plans"
+	"github.com/opentofu/opentofu/internal/states"
+)
 
 // GraphDot returns the dot formatting of a visual representation of
 // the given OpenTofu graph.
 func GraphDot(g *Graph, opts *dag.DotOpts) (string, error) {
 	return string(g.Dot(opts)), nil
 }
+
+// labelPlannedActions replaces each resource instance node in the given graph
+// that has a change in the given set of changes with a node that renders with
+// the planned action in its label.
+//
+// The resulting graph is only suitable for rendering to the user, because
+// the replacement nodes don't implement any of the node behaviors.
+func labelPlannedActions(g *Graph, changes *plans.Changes) {
+	if changes == nil {
+		return
+	}
+
+	for _, v := range g.Vertices() {
+		ri, ok := v.(GraphNodeResourceInstance)
+		if !ok {
+			continue
+		}
+		deposedKey := states.NotDeposed
+		switch v := v.(type) {
+		case GraphNodeDeposedResourceInstanceObject:
+			deposedKey = v.DeposedInstanceObjectKey()
+		case *NodeDestroyResourceInstance:
+			deposedKey = v.DeposedKey
+		}
+
+		change := changes.ResourceInstanceDeposed(ri.ResourceInstanceAddr(), deposedKey)
+		if change == nil || change.Action == plans.NoOp {
+			continue
+		}
+		g.Replace(v, &graphNodePlannedAction{
+			name:   dag.VertexName(v),
+			action: change.Action,
+		})
+	}
+}
+
+// graphNodePlannedAction is a stand-in for a resource instance node in a
+// graph rendered for the UI, labelled with the action planned for it.
+type graphNodePlannedAction struct {
+	name   string
+	action plans.Action
+}
+
+var _ dag.GraphNodeDotter = (*graphNodePlannedAction)(nil)
+
+func (n *graphNodePlannedAction) Name() string {
+	return n.name
+}
+
+func (n *graphNodePlannedAction) DotNode(name string, opts *dag.DotOpts) *dag.DotNode {
+	return &dag.DotNode{
+		Name: name,
+		Attrs: map[string]string{
+			"label": fmt.Sprintf("%s\n(%s)", name, plannedActionLabel(n.action)),
+			"shape": "box",
+		},
+	}
+}
+
+func plannedActionLabel(action plans.Action) string {
+	if action == plans.CreateThenDelete {
+		return action.Verb() + ", create before destroy"
+	}
+	return action.Verb()
+}
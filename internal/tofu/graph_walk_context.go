@@ -63,6 +63,11 @@ type ContextGraphWalker struct {
 
 	provisionerLock  sync.Mutex
 	provisionerCache map[string]provisioners.Interface
+
+	// parallelSem limits the number of nodes executed concurrently. It is
+	// one of the semaphores belonging to Context, selected by the kind of
+	// walk.
+	parallelSem Semaphore
 }
 
 func (w *ContextGraphWalker) EnterPath(path addrs.ModuleInstance) EvalContext {
@@ -141,8 +146,8 @@ func (w *ContextGraphWalker) init() {
 
 func (w *ContextGraphWalker) Execute(ctx EvalContext, n GraphNodeExecutable) tfdiags.Diagnostics {
 	// Acquire a lock on the semaphore
-	w.Context.parallelSem.Acquire()
-	defer w.Context.parallelSem.Release()
+	w.parallelSem.Acquire()
+	defer w.parallelSem.Release()
 
 	return n.Execute(ctx, w.Operation)
 }
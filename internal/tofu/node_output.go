@@ -281,7 +281,7 @@ func referencesForOutput(c *configs.Output) []*addrs.Reference {
 	var refs []*addrs.Reference
 
 	impRefs, _ := lang.ReferencesInExpr(addrs.ParseRef, c.Expr)
-	expRefs, _ := lang.References(addrs.ParseRef, c.DependsOn)
+	expRefs, _ := lang.References(parseOutputDependsOnRef, c.DependsOn)
 
 	refs = append(refs, impRefs...)
 	refs = append(refs, expRefs...)
@@ -296,6 +296,39 @@ func referencesForOutput(c *configs.Output) []*addrs.Reference {
 	return refs
 }
 
+// parseOutputDependsOnRef parses a reference in the depends_on argument of
+// an output value, which can also refer to another output value of the same
+// module as output.NAME.
+//
+// The references to other output values don't match anything in the graph's
+// reference map, since output values can't otherwise be referenced from
+// within their own module, so OutputTransformer adds the edges for them.
+func parseOutputDependsOnRef(traversal hcl.Traversal) (*addrs.Reference, tfdiags.Diagnostics) {
+	if traversal.RootName() == "output" {
+		return addrs.ParseRefFromTestingScope(traversal)
+	}
+	return addrs.ParseRef(traversal)
+}
+
+// outputDependsOnOutputs returns the names of the other output values of the
+// same module that an output value refers to in its depends_on argument.
+func outputDependsOnOutputs(c *configs.Output) []string {
+	var names []string
+	for _, traversal := range c.DependsOn {
+		if traversal.RootName() != "output" {
+			continue
+		}
+		ref, diags := addrs.ParseRefFromTestingScope(traversal)
+		if diags.HasErrors() {
+			continue // reported during validation
+		}
+		if addr, ok := ref.Subject.(addrs.OutputValue); ok {
+			names = append(names, addr.Name)
+		}
+	}
+	return names
+}
+
 // GraphNodeReferencer
 func (n *NodeApplyableOutput) References() []*addrs.Reference {
 	return referencesForOutput(n.Config)
@@ -367,7 +400,7 @@ func (n *NodeApplyableOutput) Execute(ctx EvalContext, op walkOperation) (diags
 		// We'll handle errors below, after we have loaded the module.
 		// Outputs don't have a separate mode for validation, so validate
 		// depends_on expressions here too
-		diags = diags.Append(validateDependsOn(ctx, n.Config.DependsOn))
+		diags = diags.Append(validateDependsOnRefs(ctx, parseOutputDependsOnRef, n.Config.DependsOn))
 
 		// For root module outputs in particular, an output value must be
 		// statically declared as sensitive in order to dynamically return
@@ -165,6 +165,10 @@ func (n *NodeAbstractResource) References() []*addrs.Reference {
 			refs, _ = lang.ReferencesInExpr(addrs.ParseRef, expr)
 			result = append(result, refs...)
 		}
+		for _, expr := range c.TriggersRead {
+			refs, _ = lang.ReferencesInExpr(addrs.ParseRef, expr)
+			result = append(result, refs...)
+		}
 
 		// ReferencesInBlock() requires a schema
 		if n.Schema != nil {
@@ -458,7 +462,7 @@ func (n *NodeAbstractResource) writeResourceState(ctx EvalContext, addr addrs.Ab
 		expander.SetResourceCount(addr.Module, n.Addr.Resource, count)
 
 	case n.Config != nil && n.Config.ForEach != nil:
-		forEach, forEachDiags := evaluateForEachExpression(n.Config.ForEach, ctx)
+		forEach, forEachDiags := evaluateResourceForEachExpression(n.Config, ctx)
 		diags = diags.Append(forEachDiags)
 		if forEachDiags.HasErrors() {
 			return diags
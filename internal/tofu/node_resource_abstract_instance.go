@@ -721,7 +721,7 @@ func (n *NodeAbstractResourceInstance) plan(
 	}
 
 	// Evaluate the configuration
-	forEach, _ := evaluateForEachExpression(n.Config.ForEach, ctx)
+	forEach, _ := evaluateResourceForEachExpression(n.Config, ctx)
 
 	keyData = EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
@@ -1593,6 +1593,31 @@ func (n *NodeAbstractResourceInstance) providerMetas(ctx EvalContext) (cty.Value
 	return metaConfigVal, diags
 }
 
+// readTriggered evaluates the read_triggered_by expressions of a data
+// resource, returning true if any of the referenced resources has a planned
+// change that requires the data source to be read again during apply.
+func (n *NodeAbstractResourceInstance) readTriggered(ctx EvalContext, repData instances.RepetitionData) (bool, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if n.Config == nil {
+		return false, diags
+	}
+
+	for _, expr := range n.Config.TriggersRead {
+		ref, triggered, evalDiags := ctx.EvaluateReplaceTriggeredBy(expr, repData)
+		diags = diags.Append(evalDiags)
+		if diags.HasErrors() {
+			continue
+		}
+
+		if triggered {
+			log.Printf("[DEBUG] ReadTriggeredBy deferring read of %s due to change in %s", n.Addr, ref.DisplayString())
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
 // planDataSource deals with the main part of the data resource lifecycle:
 // either actually reading from the data source or generating a plan to do so.
 //
@@ -1623,7 +1648,7 @@ func (n *NodeAbstractResourceInstance) planDataSource(ctx EvalContext, checkRule
 	objTy := schema.ImpliedType()
 	priorVal := cty.NullVal(objTy)
 
-	forEach, _ := evaluateForEachExpression(config.ForEach, ctx)
+	forEach, _ := evaluateResourceForEachExpression(&config, ctx)
 	keyData = EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
 	checkDiags := evalCheckRules(
@@ -1672,11 +1697,22 @@ func (n *NodeAbstractResourceInstance) planDataSource(ctx EvalContext, checkRule
 
 	configKnown := configVal.IsWhollyKnown()
 	depsPending := n.dependenciesHavePendingChanges(ctx)
+	// There are no planned changes to trigger a read when we're only
+	// refreshing, so read_triggered_by only applies to normal plans.
+	readTriggered := false
+	if !skipPlanChanges && !depsPending {
+		var triggerDiags tfdiags.Diagnostics
+		readTriggered, triggerDiags = n.readTriggered(ctx, keyData)
+		diags = diags.Append(triggerDiags)
+		if diags.HasErrors() {
+			return nil, nil, keyData, diags
+		}
+	}
 	// If our configuration contains any unknown values, or we depend on any
 	// unknown values then we must defer the read to the apply phase by
 	// producing a "Read" change for this resource, and a placeholder value for
 	// it in the state.
-	if depsPending || !configKnown {
+	if depsPending || readTriggered || !configKnown {
 		// We can't plan any changes if we're only refreshing, so the only
 		// value we can set here is whatever was in state previously.
 		if skipPlanChanges {
@@ -1699,6 +1735,10 @@ func (n *NodeAbstractResourceInstance) planDataSource(ctx EvalContext, checkRule
 			// specific.
 			log.Printf("[TRACE] planDataSource: %s configuration is fully known, at least one dependency has changes pending", n.Addr)
 			reason = plans.ResourceInstanceReadBecauseDependencyPending
+		case readTriggered:
+			// A change in one of the read_triggered_by resources is treated
+			// the same way as a pending change in a dependency.
+			reason = plans.ResourceInstanceReadBecauseDependencyPending
 		}
 
 		unmarkedConfigVal, configMarkPaths := configVal.UnmarkDeepWithPaths()
@@ -1903,7 +1943,7 @@ func (n *NodeAbstractResourceInstance) applyDataSource(ctx EvalContext, planned
 		return nil, keyData, diags
 	}
 
-	forEach, _ := evaluateForEachExpression(config.ForEach, ctx)
+	forEach, _ := evaluateResourceForEachExpression(&config, ctx)
 	keyData = EvalDataForInstanceKey(n.Addr.Resource.Key, forEach)
 
 	checkDiags := evalCheckRules(
@@ -2207,7 +2247,7 @@ func (n *NodeAbstractResourceInstance) applyProvisioners(ctx EvalContext, state
 func (n *NodeAbstractResourceInstance) evalProvisionerConfig(ctx EvalContext, body hcl.Body, self cty.Value, schema *configschema.Block) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
-	forEach, forEachDiags := evaluateForEachExpression(n.Config.ForEach, ctx)
+	forEach, forEachDiags := evaluateResourceForEachExpression(n.Config, ctx)
 	diags = diags.Append(forEachDiags)
 
 	keyData := EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
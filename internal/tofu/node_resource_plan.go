@@ -26,6 +26,11 @@ type nodeExpandPlannableResource struct {
 	// on regardless of what the configuration says.
 	ForceCreateBeforeDestroy *bool
 
+	// cbdForcedBy is the address of a resource that depends on this one and
+	// whose configuration sets create_before_destroy, if that's why
+	// ForceCreateBeforeDestroy was set.
+	cbdForcedBy *addrs.ConfigResource
+
 	// skipRefresh indicates that we should skip refreshing individual instances
 	skipRefresh bool
 
@@ -41,6 +46,12 @@ type nodeExpandPlannableResource struct {
 	// that this node represents, which the node itself must therefore ignore.
 	forceReplace []addrs.AbsResourceInstance
 
+	// forceReplaceModules are module instances all of whose managed resource
+	// instances are to be treated as if they were in forceReplace. Whether
+	// an instance is in one of them can only be decided once its module has
+	// been expanded, so we add matching instances in DynamicExpand.
+	forceReplaceModules []addrs.ModuleInstance
+
 	// We attach dependencies to the Resource during refresh, since the
 	// instances are instantiated during DynamicExpand.
 	// FIXME: These would be better off converted to a generic Set data
@@ -51,6 +62,7 @@ type nodeExpandPlannableResource struct {
 
 var (
 	_ GraphNodeDestroyerCBD         = (*nodeExpandPlannableResource)(nil)
+	_ graphNodeForcedCBD            = (*nodeExpandPlannableResource)(nil)
 	_ GraphNodeDynamicExpandable    = (*nodeExpandPlannableResource)(nil)
 	_ GraphNodeReferenceable        = (*nodeExpandPlannableResource)(nil)
 	_ GraphNodeReferencer           = (*nodeExpandPlannableResource)(nil)
@@ -93,6 +105,11 @@ func (n *nodeExpandPlannableResource) ModifyCreateBeforeDestroy(v bool) error {
 	return nil
 }
 
+// graphNodeForcedCBD
+func (n *nodeExpandPlannableResource) createBeforeDestroyForcedBy(addr addrs.ConfigResource) {
+	n.cbdForcedBy = &addr
+}
+
 func (n *nodeExpandPlannableResource) DynamicExpand(ctx EvalContext) (*Graph, error) {
 	var g Graph
 
@@ -309,6 +326,23 @@ func (n *nodeExpandPlannableResource) expandResourceInstances(globalCtx EvalCont
 	return diags.ErrWithWarnings()
 }
 
+// forceReplaceFor returns the force-replace addresses to give the node of
+// the resource instance with the given address, which includes that address
+// itself if it's a managed resource instance in one of n.forceReplaceModules.
+func (n *nodeExpandPlannableResource) forceReplaceFor(addr addrs.AbsResourceInstance) []addrs.AbsResourceInstance {
+	if addr.Resource.Resource.Mode != addrs.ManagedResourceMode {
+		return n.forceReplace
+	}
+	for _, module := range n.forceReplaceModules {
+		if module.TargetContains(addr) {
+			ret := make([]addrs.AbsResourceInstance, 0, len(n.forceReplace)+1)
+			ret = append(ret, n.forceReplace...)
+			return append(ret, addr)
+		}
+	}
+	return n.forceReplace
+}
+
 func (n *nodeExpandPlannableResource) resourceInstanceSubgraph(ctx EvalContext, addr addrs.AbsResource, instanceAddrs []addrs.AbsResourceInstance) (*Graph, error) {
 	var diags tfdiags.Diagnostics
 
@@ -362,9 +396,10 @@ func (n *nodeExpandPlannableResource) resourceInstanceSubgraph(ctx EvalContext,
 			// to force on CreateBeforeDestroy due to dependencies on other
 			// nodes that have it.
 			ForceCreateBeforeDestroy: n.CreateBeforeDestroy(),
+			cbdForcedBy:              n.cbdForcedBy,
 			skipRefresh:              n.skipRefresh,
 			skipPlanChanges:          n.skipPlanChanges,
-			forceReplace:             n.forceReplace,
+			forceReplace:             n.forceReplaceFor(a.Addr),
 		}
 
 		resolvedImportTarget := ctx.ImportResolver().GetImport(a.Addr)
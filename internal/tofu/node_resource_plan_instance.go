@@ -33,6 +33,11 @@ type NodePlannableResourceInstance struct {
 	*NodeAbstractResourceInstance
 	ForceCreateBeforeDestroy bool
 
+	// cbdForcedBy is the address of a resource whose create_before_destroy
+	// setting caused ForceCreateBeforeDestroy to be set, if any, so that we
+	// can explain an unexpected create_before_destroy replacement.
+	cbdForcedBy *addrs.ConfigResource
+
 	// skipRefresh indicates that we should skip refreshing individual instances
 	skipRefresh bool
 
@@ -320,6 +325,8 @@ func (n *NodePlannableResourceInstance) managedResourceExecute(ctx EvalContext)
 			change.ActionReason = plans.ResourceInstanceReplaceByTriggers
 		}
 
+		diags = diags.Append(n.forcedCreateBeforeDestroyWarning(change))
+
 		// FIXME: it is currently important that we write resource changes to
 		// the plan (n.writeChange) before we write the corresponding state
 		// (n.writeResourceInstanceState).
@@ -368,13 +375,24 @@ func (n *NodePlannableResourceInstance) managedResourceExecute(ctx EvalContext)
 		// until the user makes the condition succeed.
 		// (Note that some preconditions will end up being skipped during
 		// planning, because their conditions depend on values not yet known.)
-		checkDiags := evalCheckRules(
-			addrs.ResourcePostcondition,
-			n.Config.Postconditions,
-			ctx, n.ResourceInstanceAddr(), repeatData,
-			checkRuleSeverity,
-		)
-		diags = diags.Append(checkDiags)
+		//
+		// We don't check the postconditions of an object that is about to be
+		// destroyed, which includes every object during the refresh that
+		// starts a destroy plan: asserting invariants about an object we're
+		// removing rarely makes sense, and the object might already be
+		// partially gone. The preconditions above still apply, because they
+		// may be what gates the destroy.
+		if n.preDestroyRefresh || change.Action == plans.Delete {
+			log.Printf("[TRACE] managedResourceExecute: skipping postconditions for %s, which is being destroyed", addr)
+		} else {
+			checkDiags := evalCheckRules(
+				addrs.ResourcePostcondition,
+				n.Config.Postconditions,
+				ctx, n.ResourceInstanceAddr(), repeatData,
+				checkRuleSeverity,
+			)
+			diags = diags.Append(checkDiags)
+		}
 	} else {
 		// In refresh-only mode we need to evaluate the for-each expression in
 		// order to supply the value to the pre- and post-condition check
@@ -383,7 +401,7 @@ func (n *NodePlannableResourceInstance) managedResourceExecute(ctx EvalContext)
 		// values, which could result in a post-condition check relying on that
 		// value being inaccurate. Unless we decide to store the value of the
 		// for-each expression in state, this is unavoidable.
-		forEach, _ := evaluateForEachExpression(n.Config.ForEach, ctx)
+		forEach, _ := evaluateResourceForEachExpression(n.Config, ctx)
 		repeatData := EvalDataForInstanceKey(n.ResourceInstanceAddr().Resource.Key, forEach)
 
 		checkDiags := evalCheckRules(
@@ -738,3 +756,28 @@ func depsEqual(a, b []addrs.ConfigResource) bool {
 	}
 	return true
 }
+
+// forcedCreateBeforeDestroyWarning returns a warning if the given change
+// replaces the instance using create_before_destroy only because a dependent
+// resource has create_before_destroy set, since the resulting order of
+// operations can be surprising when the configuration doesn't ask for it.
+func (n *NodePlannableResourceInstance) forcedCreateBeforeDestroyWarning(change *plans.ResourceInstanceChange) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if change.Action != plans.CreateThenDelete || n.cbdForcedBy == nil {
+		return diags
+	}
+	if n.Config == nil || n.Config.Managed == nil || n.Config.Managed.CreateBeforeDestroy {
+		return diags
+	}
+
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagWarning,
+		Summary:  "Create before destroy inferred from a dependent resource",
+		Detail: fmt.Sprintf(
+			"%s must be replaced. Its configuration doesn't set create_before_destroy, but OpenTofu will create the replacement before destroying the existing object because %s depends on it and has create_before_destroy set.\n\nTo make this explicit and silence this warning, set create_before_destroy = true in the lifecycle block of this resource.",
+			n.Addr, n.cbdForcedBy,
+		),
+		Subject: n.Config.DeclRange.Ptr(),
+	})
+	return diags
+}
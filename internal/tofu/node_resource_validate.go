@@ -311,7 +311,7 @@ func (n *NodeValidatableResource) validateResource(ctx EvalContext) tfdiags.Diag
 		}
 
 		// Evaluate the for_each expression here so we can expose the diagnostics
-		forEachDiags := validateForEach(ctx, n.Config.ForEach)
+		forEachDiags := validateForEach(ctx, n.Config.ForEach, n.Config.ForEachKeysHashed)
 		diags = diags.Append(forEachDiags)
 	}
 
@@ -564,11 +564,17 @@ func validateCount(ctx EvalContext, expr hcl.Expression) (diags tfdiags.Diagnost
 	return diags
 }
 
-func validateForEach(ctx EvalContext, expr hcl.Expression) (diags tfdiags.Diagnostics) {
+func validateForEach(ctx EvalContext, expr hcl.Expression, hashedKeys bool) (diags tfdiags.Diagnostics) {
 	const unknownsAllowed = true
 	const tupleNotAllowed = false
 
-	val, forEachDiags := evaluateForEachExpressionValue(expr, ctx, unknownsAllowed, tupleNotAllowed)
+	var val cty.Value
+	var forEachDiags tfdiags.Diagnostics
+	if hashedKeys {
+		val, forEachDiags = evaluateHashedForEachExpressionValue(expr, ctx, unknownsAllowed)
+	} else {
+		val, forEachDiags = evaluateForEachExpressionValue(expr, ctx, unknownsAllowed, tupleNotAllowed)
+	}
 	// If the value isn't known then that's the best we can do for now, but
 	// we'll check more thoroughly during the plan walk
 	if !val.IsKnown() {
@@ -583,8 +589,15 @@ func validateForEach(ctx EvalContext, expr hcl.Expression) (diags tfdiags.Diagno
 }
 
 func validateDependsOn(ctx EvalContext, dependsOn []hcl.Traversal) (diags tfdiags.Diagnostics) {
+	return validateDependsOnRefs(ctx, addrs.ParseRef, dependsOn)
+}
+
+// validateDependsOnRefs is like validateDependsOn, but parses the references
+// with the given function to allow for the addresses that are valid in
+// depends_on only in particular contexts.
+func validateDependsOnRefs(ctx EvalContext, parseRef lang.ParseRef, dependsOn []hcl.Traversal) (diags tfdiags.Diagnostics) {
 	for _, traversal := range dependsOn {
-		ref, refDiags := addrs.ParseRef(traversal)
+		ref, refDiags := parseRef(traversal)
 		diags = diags.Append(refDiags)
 		if !refDiags.HasErrors() && len(ref.Remaining) != 0 {
 			diags = diags.Append(&hcl.Diagnostic{
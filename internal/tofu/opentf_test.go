@@ -227,6 +227,14 @@ func mustAbsResourceAddr(s string) addrs.AbsResource {
 	return addr
 }
 
+func mustModuleInstance(s string) addrs.ModuleInstance {
+	addr, diags := addrs.ParseModuleInstanceStr(s)
+	if diags.HasErrors() {
+		panic(diags.Err())
+	}
+	return addr
+}
+
 func mustProviderConfig(s string) addrs.AbsProviderConfig {
 	p, diags := addrs.ParseAbsProviderConfigStr(s)
 	if diags.HasErrors() {
@@ -0,0 +1,216 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/providers"
+)
+
+// ProviderTraceEnvVar is the environment variable naming a file that
+// receives one JSON line for each call OpenTofu makes to a provider,
+// recording how long the call took.
+const ProviderTraceEnvVar = "TOFU_PROVIDER_TRACE"
+
+//nolint:gochecknoglobals // the trace file is shared by every context in the process
+var (
+	envProviderTracerOnce sync.Once
+	envProviderTracer     *providerTracer
+)
+
+// providerTracerFromEnv returns the tracer writing to the file named by
+// ProviderTraceEnvVar, opening the file the first time it's called, or nil
+// if tracing isn't enabled.
+func providerTracerFromEnv() *providerTracer {
+	envProviderTracerOnce.Do(func() {
+		path := os.Getenv(ProviderTraceEnvVar)
+		if path == "" {
+			return
+		}
+		// We append so that the calls of several commands run one after
+		// another, such as a plan followed by an apply, can be collected
+		// in a single file. Each record is written with a single unbuffered
+		// write, so the file doesn't need to be flushed or closed.
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			log.Printf("[WARN] Failed to open provider trace file %s: %s", path, err)
+			return
+		}
+		log.Printf("[INFO] Writing provider call trace to %s", path)
+		envProviderTracer = newProviderTracer(f)
+	})
+	return envProviderTracer
+}
+
+// providerCallRecord is the JSON representation of a single provider call
+// in the trace file.
+type providerCallRecord struct {
+	Provider     string    `json:"provider"`
+	Method       string    `json:"method"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	Function     string    `json:"function,omitempty"`
+	Start        time.Time `json:"start"`
+	DurationMS   float64   `json:"duration_ms"`
+}
+
+// providerTracer writes a providerCallRecord for each provider call to a
+// writer. It is safe for concurrent use.
+type providerTracer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newProviderTracer(w io.Writer) *providerTracer {
+	return &providerTracer{w: w}
+}
+
+func (t *providerTracer) record(provider addrs.Provider, method, resourceType string, start time.Time) {
+	t.write(providerCallRecord{
+		Provider:     provider.String(),
+		Method:       method,
+		ResourceType: resourceType,
+	}, start)
+}
+
+func (t *providerTracer) recordFunction(provider addrs.Provider, function string, start time.Time) {
+	t.write(providerCallRecord{
+		Provider: provider.String(),
+		Method:   "CallFunction",
+		Function: function,
+	}, start)
+}
+
+// write completes the given record with the timing of a call that began at
+// start and writes it out.
+func (t *providerTracer) write(rec providerCallRecord, start time.Time) {
+	rec.Start = start.UTC()
+	rec.DurationMS = float64(time.Since(start)) / float64(time.Millisecond)
+	line, err := json.Marshal(rec)
+	if err != nil {
+		// Can't happen, since the record has only plain fields.
+		log.Printf("[WARN] Failed to encode provider trace record: %s", err)
+		return
+	}
+	line = append(line, '\n')
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := t.w.Write(line); err != nil {
+		log.Printf("[WARN] Failed to write provider trace record: %s", err)
+	}
+}
+
+// newTracedProvider wraps the given provider so that its calls are recorded
+// with the given tracer.
+func newTracedProvider(p providers.Interface, addr addrs.Provider, tracer *providerTracer) providers.Interface {
+	traced := &tracedProvider{Interface: p, addr: addr, tracer: tracer}
+	if enc, ok := p.(ProviderWithEncryption); ok {
+		// The wrapper must keep the extra method visible, since core
+		// checks for it rather than always calling ReadDataSource.
+		return &tracedProviderWithEncryption{tracedProvider: traced, enc: enc}
+	}
+	return traced
+}
+
+// tracedProvider wraps a provider to record the duration of each of its
+// calls with a providerTracer. Stop and Close are passed through untraced.
+type tracedProvider struct {
+	providers.Interface
+
+	addr   addrs.Provider
+	tracer *providerTracer
+}
+
+var _ providers.Interface = (*tracedProvider)(nil)
+
+// tracedProviderWithEncryption is a tracedProvider for a provider that also
+// implements ProviderWithEncryption, such as the builtin provider's
+// terraform_remote_state data source.
+type tracedProviderWithEncryption struct {
+	*tracedProvider
+
+	enc ProviderWithEncryption
+}
+
+var _ ProviderWithEncryption = (*tracedProviderWithEncryption)(nil)
+
+func (p *tracedProviderWithEncryption) ReadDataSourceEncrypted(req providers.ReadDataSourceRequest, path addrs.AbsResourceInstance, enc encryption.Encryption) providers.ReadDataSourceResponse {
+	defer p.tracer.record(p.addr, "ReadDataSourceEncrypted", req.TypeName, time.Now())
+	return p.enc.ReadDataSourceEncrypted(req, path, enc)
+}
+
+func (p *tracedProvider) GetProviderSchema() providers.GetProviderSchemaResponse {
+	defer p.tracer.record(p.addr, "GetProviderSchema", "", time.Now())
+	return p.Interface.GetProviderSchema()
+}
+
+func (p *tracedProvider) ValidateProviderConfig(req providers.ValidateProviderConfigRequest) providers.ValidateProviderConfigResponse {
+	defer p.tracer.record(p.addr, "ValidateProviderConfig", "", time.Now())
+	return p.Interface.ValidateProviderConfig(req)
+}
+
+func (p *tracedProvider) ValidateResourceConfig(req providers.ValidateResourceConfigRequest) providers.ValidateResourceConfigResponse {
+	defer p.tracer.record(p.addr, "ValidateResourceConfig", req.TypeName, time.Now())
+	return p.Interface.ValidateResourceConfig(req)
+}
+
+func (p *tracedProvider) ValidateDataResourceConfig(req providers.ValidateDataResourceConfigRequest) providers.ValidateDataResourceConfigResponse {
+	defer p.tracer.record(p.addr, "ValidateDataResourceConfig", req.TypeName, time.Now())
+	return p.Interface.ValidateDataResourceConfig(req)
+}
+
+func (p *tracedProvider) UpgradeResourceState(req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	defer p.tracer.record(p.addr, "UpgradeResourceState", req.TypeName, time.Now())
+	return p.Interface.UpgradeResourceState(req)
+}
+
+func (p *tracedProvider) ConfigureProvider(req providers.ConfigureProviderRequest) providers.ConfigureProviderResponse {
+	defer p.tracer.record(p.addr, "ConfigureProvider", "", time.Now())
+	return p.Interface.ConfigureProvider(req)
+}
+
+func (p *tracedProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	defer p.tracer.record(p.addr, "ReadResource", req.TypeName, time.Now())
+	return p.Interface.ReadResource(req)
+}
+
+func (p *tracedProvider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	defer p.tracer.record(p.addr, "PlanResourceChange", req.TypeName, time.Now())
+	return p.Interface.PlanResourceChange(req)
+}
+
+func (p *tracedProvider) ApplyResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	defer p.tracer.record(p.addr, "ApplyResourceChange", req.TypeName, time.Now())
+	return p.Interface.ApplyResourceChange(req)
+}
+
+func (p *tracedProvider) ImportResourceState(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	defer p.tracer.record(p.addr, "ImportResourceState", req.TypeName, time.Now())
+	return p.Interface.ImportResourceState(req)
+}
+
+func (p *tracedProvider) ReadDataSource(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	defer p.tracer.record(p.addr, "ReadDataSource", req.TypeName, time.Now())
+	return p.Interface.ReadDataSource(req)
+}
+
+func (p *tracedProvider) GetFunctions() providers.GetFunctionsResponse {
+	defer p.tracer.record(p.addr, "GetFunctions", "", time.Now())
+	return p.Interface.GetFunctions()
+}
+
+func (p *tracedProvider) CallFunction(req providers.CallFunctionRequest) providers.CallFunctionResponse {
+	defer p.tracer.recordFunction(p.addr, req.Name, time.Now())
+	return p.Interface.CallFunction(req)
+}
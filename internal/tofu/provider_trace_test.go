@@ -0,0 +1,176 @@
+// Copyright (c) The OpenTofu Authors
+// SPDX-License-Identifier: MPL-2.0
+// Copyright (c) 2023 HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tofu
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/opentofu/opentofu/internal/addrs"
+	"github.com/opentofu/opentofu/internal/encryption"
+	"github.com/opentofu/opentofu/internal/providers"
+	"github.com/opentofu/opentofu/internal/states"
+)
+
+func TestProviderTracer_plan(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_object" "a" {
+  test_string = "foo"
+}
+`,
+	})
+
+	p := simpleMockProvider()
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewDefaultProvider("test"): testProviderFuncFixed(p),
+		},
+	})
+	var buf bytes.Buffer
+	ctx.plugins.providerTracer = newProviderTracer(&buf)
+
+	_, diags := ctx.Plan(m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	records := decodeProviderTrace(t, &buf)
+	var found bool
+	for _, rec := range records {
+		if rec.Provider != "registry.opentofu.org/hashicorp/test" {
+			t.Errorf("wrong provider %q in %#v", rec.Provider, rec)
+		}
+		if rec.DurationMS < 0 {
+			t.Errorf("negative duration in %#v", rec)
+		}
+		if rec.Method == "PlanResourceChange" {
+			found = true
+			if rec.ResourceType != "test_object" {
+				t.Errorf("wrong resource type %q for PlanResourceChange", rec.ResourceType)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("no PlanResourceChange call in trace:\n%s", buf.String())
+	}
+}
+
+func TestProviderTracer_remoteState(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+data "terraform_remote_state" "a" {
+  test_string = "foo"
+}
+`,
+	})
+
+	// The builtin provider can't be imported here, so this stands in for
+	// it: like the real terraform_remote_state, it must only be read
+	// through ReadDataSourceEncrypted.
+	p := &encryptedReadMockProvider{MockProvider: &MockProvider{
+		GetProviderSchemaResponse: &providers.GetProviderSchemaResponse{
+			DataSources: map[string]providers.Schema{
+				"terraform_remote_state": {Block: simpleTestSchema()},
+			},
+		},
+		ReadDataSourceFn: func(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+			return providers.ReadDataSourceResponse{State: req.Config}
+		},
+	}}
+	ctx := testContext2(t, &ContextOpts{
+		Providers: map[addrs.Provider]providers.Factory{
+			addrs.NewBuiltInProvider("terraform"): testProviderFuncFixed(p),
+		},
+	})
+	var buf bytes.Buffer
+	ctx.plugins.providerTracer = newProviderTracer(&buf)
+
+	_, diags := ctx.Plan(m, states.NewState(), DefaultPlanOpts)
+	assertNoErrors(t, diags)
+
+	var found bool
+	for _, rec := range decodeProviderTrace(t, &buf) {
+		if rec.Method == "ReadDataSourceEncrypted" && rec.ResourceType == "terraform_remote_state" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("no ReadDataSourceEncrypted call in trace:\n%s", buf.String())
+	}
+}
+
+func TestProviderTracer_callFunction(t *testing.T) {
+	var buf bytes.Buffer
+	addr := addrs.NewDefaultProvider("test")
+	p := newTracedProvider(&MockProvider{
+		CallFunctionResponse: &providers.CallFunctionResponse{Result: cty.True},
+	}, addr, newProviderTracer(&buf))
+
+	p.CallFunction(providers.CallFunctionRequest{Name: "echo"})
+
+	records := decodeProviderTrace(t, &buf)
+	if len(records) != 1 {
+		t.Fatalf("wrong number of records %d; want 1", len(records))
+	}
+	if got := records[0]; got.Method != "CallFunction" || got.Function != "echo" {
+		t.Fatalf("wrong record %#v; want CallFunction of echo", got)
+	}
+}
+
+// encryptedReadMockProvider is a MockProvider whose data sources can only be
+// read through ReadDataSourceEncrypted, as with the builtin provider.
+type encryptedReadMockProvider struct {
+	*MockProvider
+}
+
+func (p *encryptedReadMockProvider) ReadDataSource(providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	panic("ReadDataSource called instead of ReadDataSourceEncrypted")
+}
+
+func (p *encryptedReadMockProvider) ReadDataSourceEncrypted(req providers.ReadDataSourceRequest, _ addrs.AbsResourceInstance, _ encryption.Encryption) providers.ReadDataSourceResponse {
+	return p.MockProvider.ReadDataSource(req)
+}
+
+func TestProviderTracer_concurrent(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := newProviderTracer(&buf)
+	addr := addrs.NewDefaultProvider("test")
+
+	const calls = 100
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tracer.record(addr, "ReadResource", "test_object", time.Now())
+		}()
+	}
+	wg.Wait()
+
+	if got := len(decodeProviderTrace(t, &buf)); got != calls {
+		t.Fatalf("wrong number of records %d; want %d", got, calls)
+	}
+}
+
+func decodeProviderTrace(t *testing.T, buf *bytes.Buffer) []providerCallRecord {
+	t.Helper()
+
+	var records []providerCallRecord
+	sc := bufio.NewScanner(bytes.NewReader(buf.Bytes()))
+	for sc.Scan() {
+		var rec providerCallRecord
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid trace line %q: %s", sc.Text(), err)
+		}
+		records = append(records, rec)
+	}
+	return records
+}
@@ -304,6 +304,54 @@ func TestProcessIgnoreChangesIndividual(t *testing.T) {
 				),
 			}),
 		},
+		"map_index_absent_in_config": {
+			// The ignored key is only present in the prior state, while the
+			// other keys of the map still have changes to plan.
+			cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{
+					"Name":           cty.StringVal("old name"),
+					"LastModifiedBy": cty.StringVal("external"),
+				}),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{
+					"Name": cty.StringVal("new name"),
+				}),
+			}),
+			[]string{`tags["LastModifiedBy"]`},
+			cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{
+					"Name":           cty.StringVal("new name"),
+					"LastModifiedBy": cty.StringVal("external"),
+				}),
+			}),
+		},
+		"nested_map_index": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a": cty.MapVal(map[string]cty.Value{
+					"k": cty.MapVal(map[string]cty.Value{
+						"inner": cty.StringVal("inner value"),
+						"other": cty.StringVal("other value"),
+					}),
+				}),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"a": cty.MapVal(map[string]cty.Value{
+					"k": cty.MapVal(map[string]cty.Value{
+						"other": cty.StringVal("new other value"),
+					}),
+				}),
+			}),
+			[]string{`a["k"]["inner"]`},
+			cty.ObjectVal(map[string]cty.Value{
+				"a": cty.MapVal(map[string]cty.Value{
+					"k": cty.MapVal(map[string]cty.Value{
+						"inner": cty.StringVal("inner value"),
+						"other": cty.StringVal("new other value"),
+					}),
+				}),
+			}),
+		},
 		"missing_prior_map_index": {
 			cty.ObjectVal(map[string]cty.Value{
 				"a": cty.MapVal(map[string]cty.Value{
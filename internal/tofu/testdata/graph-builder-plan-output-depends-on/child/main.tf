@@ -0,0 +1,8 @@
+output "first" {
+  value = "first"
+}
+
+output "second" {
+  value      = "second"
+  depends_on = [output.first]
+}
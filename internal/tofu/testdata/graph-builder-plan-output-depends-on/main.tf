@@ -0,0 +1,18 @@
+resource "test_object" "a" {
+  test_string = "a"
+}
+
+output "first" {
+  value = test_object.a.test_string
+}
+
+# There's no reference to output.first in the value, so only depends_on
+# orders this output after the other one.
+output "summary" {
+  value      = "done"
+  depends_on = [output.first]
+}
+
+module "child" {
+  source = "./child"
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs"
 	"github.com/opentofu/opentofu/internal/dag"
 	"github.com/opentofu/opentofu/internal/states"
@@ -28,6 +29,18 @@ type GraphNodeDestroyerCBD interface {
 	ModifyCreateBeforeDestroy(bool) error
 }
 
+// graphNodeForcedCBD is implemented by nodes that want to know which
+// dependent resource caused ForcedCBDTransformer to force
+// create_before_destroy on for them, so that they can explain it.
+type graphNodeForcedCBD interface {
+	GraphNodeDestroyerCBD
+
+	// createBeforeDestroyForcedBy is called with the address of a resource
+	// whose configuration sets create_before_destroy and which depends,
+	// directly or indirectly, on the receiver.
+	createBeforeDestroyForcedBy(addrs.ConfigResource)
+}
+
 // ForcedCBDTransformer detects when a particular CBD-able graph node has
 // dependencies with another that has create_before_destroy set that require
 // it to be forced on, and forces it on.
@@ -40,6 +53,12 @@ type ForcedCBDTransformer struct {
 }
 
 func (t *ForcedCBDTransformer) Transform(g *Graph) error {
+	// forcedBy records, for each node we've forced on, the descendent that
+	// has create_before_destroy set in its own configuration. A descendent
+	// we find might itself have been forced on by an earlier iteration, in
+	// which case we report the descendent that caused that instead.
+	forcedBy := make(map[dag.Vertex]dag.Vertex)
+
 	for _, v := range g.Vertices() {
 		dn, ok := v.(GraphNodeDestroyerCBD)
 		if !ok {
@@ -49,7 +68,8 @@ func (t *ForcedCBDTransformer) Transform(g *Graph) error {
 		if !dn.CreateBeforeDestroy() {
 			// If there are no CBD descendent (dependent nodes), then we
 			// do nothing here.
-			if !t.hasCBDDescendent(g, v) {
+			descendent, ok := t.cbdDescendent(g, v)
+			if !ok {
 				log.Printf("[TRACE] ForcedCBDTransformer: %q (%T) has no CBD descendent, so skipping", dag.VertexName(v), v)
 				continue
 			}
@@ -66,6 +86,18 @@ func (t *ForcedCBDTransformer) Transform(g *Graph) error {
 						"attempting to automatically do this, an error occurred: %w",
 					dag.VertexName(v), err)
 			}
+
+			if origin, ok := forcedBy[descendent]; ok {
+				descendent = origin
+			}
+			forcedBy[v] = descendent
+			fn, ok := v.(graphNodeForcedCBD)
+			if !ok {
+				continue
+			}
+			if rn, ok := descendent.(GraphNodeConfigResource); ok {
+				fn.createBeforeDestroyForcedBy(rn.ResourceAddr())
+			}
 		} else {
 			log.Printf("[TRACE] ForcedCBDTransformer: %q (%T) already has create_before_destroy set", dag.VertexName(v), v)
 		}
@@ -73,12 +105,15 @@ func (t *ForcedCBDTransformer) Transform(g *Graph) error {
 	return nil
 }
 
-// hasCBDDescendent returns true if any descendent (node that depends on this)
-// has CBD set.
-func (t *ForcedCBDTransformer) hasCBDDescendent(g *Graph, v dag.Vertex) bool {
+// cbdDescendent returns true if any descendent (node that depends on this)
+// has CBD set, along with the first such descendent it finds.
+//
+// The returned descendent is nil if the descendents of the node couldn't be
+// determined, in which case we assume there is one.
+func (t *ForcedCBDTransformer) cbdDescendent(g *Graph, v dag.Vertex) (dag.Vertex, bool) {
 	s, _ := g.Descendents(v)
 	if s == nil {
-		return true
+		return nil, true
 	}
 
 	for _, ov := range s {
@@ -90,11 +125,11 @@ func (t *ForcedCBDTransformer) hasCBDDescendent(g *Graph, v dag.Vertex) bool {
 		if dn.CreateBeforeDestroy() {
 			// some descendent is CreateBeforeDestroy, so we need to follow suit
 			log.Printf("[TRACE] ForcedCBDTransformer: %q has CBD descendent %q", dag.VertexName(v), dag.VertexName(ov))
-			return true
+			return ov, true
 		}
 	}
 
-	return false
+	return nil, false
 }
 
 // CBDEdgeTransformer modifies the edges of create-before-destroy ("CBD") nodes
@@ -10,6 +10,7 @@ import (
 
 	"github.com/opentofu/opentofu/internal/addrs"
 	"github.com/opentofu/opentofu/internal/configs"
+	"github.com/opentofu/opentofu/internal/dag"
 )
 
 // OutputTransformer is a GraphTransformer that adds all the outputs
@@ -53,6 +54,7 @@ func (t *OutputTransformer) transform(g *Graph, c *configs.Config) error {
 		}
 	}
 
+	nodes := make(map[string]*nodeExpandOutput, len(c.Module.Outputs))
 	for _, o := range c.Module.Outputs {
 		addr := addrs.OutputValue{Name: o.Name}
 
@@ -67,6 +69,19 @@ func (t *OutputTransformer) transform(g *Graph, c *configs.Config) error {
 
 		log.Printf("[TRACE] OutputTransformer: adding %s as %T", o.Name, node)
 		g.Add(node)
+		nodes[o.Name] = node
+	}
+
+	// An output value can depend on another output value of the same module
+	// only through depends_on, since they can't refer to each other in
+	// expressions, so ReferenceTransformer won't connect them.
+	for name, node := range nodes {
+		for _, dep := range outputDependsOnOutputs(node.Config) {
+			if target, ok := nodes[dep]; ok {
+				log.Printf("[TRACE] OutputTransformer: %s depends on %s", name, dep)
+				g.Connect(dag.BasicEdge(node, target))
+			}
+		}
 	}
 
 	return nil
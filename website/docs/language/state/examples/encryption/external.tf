@@ -0,0 +1,8 @@
+terraform {
+  encryption {
+    key_provider "external" "my_hsm" {
+      # Required. The program to run, followed by its arguments.
+      command = ["./hsm-key-provider", "--key-label", "tofu"]
+    }
+  }
+}